@@ -10,7 +10,8 @@ import (
 type Client struct{}
 
 var (
-	notSet = "NOT_SET"
+	notSet                = "NOT_SET"
+	defaultKueueNamespace = "kueue-system"
 )
 
 func New() *Client {
@@ -19,7 +20,7 @@ func New() *Client {
 
 // String returns a string representation of the client
 func (c Client) String() string {
-	return fmt.Sprintf("MOCK_MODE=%s, IsMockMode=%t, HTTP_PROXY=%s, HTTPS_PROXY=%s, SOCKS5_PROXY=%s", c.MockModeEnv(), c.IsMockMode(), c.HttpProxyEnv(), c.HttpsProxyEnv(), c.Socks5ProxyEnv())
+	return fmt.Sprintf("MOCK_MODE=%s, IsMockMode=%t, HTTP_PROXY=%s, HTTPS_PROXY=%s, SOCKS5_PROXY=%s, KUEUE_NAMESPACE=%s", c.MockModeEnv(), c.IsMockMode(), c.HttpProxyEnv(), c.HttpsProxyEnv(), c.Socks5ProxyEnv(), c.KueueNamespaceEnv())
 }
 
 // MockMode returns the MOCK_MODE env value
@@ -80,3 +81,13 @@ func (c Client) Socks5ProxyEnv() string {
 func (c Client) Socks5ProxyMode() bool {
 	return strings.TrimSpace(os.Getenv("SOCKS5_PROXY")) != ""
 }
+
+// KueueNamespaceEnv returns the KUEUE_NAMESPACE env value, the namespace the Kueue controller manager runs in
+// -> Defaults to kueue-system
+func (c Client) KueueNamespaceEnv() string {
+	v := strings.TrimSpace(os.Getenv("KUEUE_NAMESPACE"))
+	if len(v) > 0 {
+		return v
+	}
+	return defaultKueueNamespace
+}
@@ -0,0 +1,88 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+func (c Client) ListAdmissionChecks() ([]models.AdmissionCheck, error) {
+	list, err := c.KueueClientset.KueueV1beta2().AdmissionChecks().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing admission checks: %w", err)
+	}
+
+	var result []models.AdmissionCheck
+	for _, ac := range list.Items {
+		result = append(result, toAdmissionCheckModel(&ac))
+	}
+	return result, nil
+}
+
+func (c Client) GetAdmissionCheck(name string) (*models.AdmissionCheck, error) {
+	ac, err := c.KueueClientset.KueueV1beta2().AdmissionChecks().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting admission check: %w", err)
+	}
+
+	result := toAdmissionCheckModel(ac)
+	return &result, nil
+}
+
+func (c Client) CreateAdmissionCheck(ac models.AdmissionCheck) (*models.AdmissionCheck, error) {
+	obj := &kueuev1beta2.AdmissionCheck{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ac.Name,
+		},
+		Spec: kueuev1beta2.AdmissionCheckSpec{
+			ControllerName: ac.ControllerName,
+		},
+	}
+
+	if p := ac.Parameters; p != nil {
+		obj.Spec.Parameters = &kueuev1beta2.AdmissionCheckParametersReference{
+			APIGroup: p.APIGroup,
+			Kind:     p.Kind,
+			Name:     p.Name,
+		}
+	}
+
+	created, err := c.KueueClientset.KueueV1beta2().AdmissionChecks().Create(context.TODO(), obj, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating admission check: %w", err)
+	}
+
+	result := toAdmissionCheckModel(created)
+	return &result, nil
+}
+
+func (c Client) DeleteAdmissionCheck(name string) error {
+	err := c.KueueClientset.KueueV1beta2().AdmissionChecks().Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed deleting admission check: %w", err)
+	}
+	return nil
+}
+
+func toAdmissionCheckModel(ac *kueuev1beta2.AdmissionCheck) models.AdmissionCheck {
+	m := models.AdmissionCheck{
+		Active:         meta.IsStatusConditionTrue(ac.Status.Conditions, kueuev1beta2.AdmissionCheckActive),
+		Conditions:     toConditionModels(ac.Status.Conditions),
+		ControllerName: ac.Spec.ControllerName,
+		Name:           ac.Name,
+	}
+
+	if p := ac.Spec.Parameters; p != nil {
+		m.Parameters = &models.AdmissionCheckParameters{
+			APIGroup: p.APIGroup,
+			Kind:     p.Kind,
+			Name:     p.Name,
+		}
+	}
+
+	return m
+}
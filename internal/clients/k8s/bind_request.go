@@ -0,0 +1,68 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"fmt"
+
+	kaiSchedulingV1alpha2 "github.com/kai-scheduler/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListBindRequests returns the KAI Scheduler BindRequests of a namespace, or of all namespaces when empty,
+// optionally only those of a pod, a node or in a phase
+func (c Client) ListBindRequests(namespace, pod, node, phase string) ([]models.BindRequest, error) {
+	list, err := c.KAIBindRequestClient.BindRequests(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing bind requests: %w", err)
+	}
+
+	var result []models.BindRequest
+	for _, br := range list.Items {
+		if pod != "" && br.Spec.PodName != pod {
+			continue
+		}
+		if node != "" && br.Spec.SelectedNode != node {
+			continue
+		}
+		if phase != "" && br.Status.Phase != phase {
+			continue
+		}
+		result = append(result, toBindRequestModel(&br))
+	}
+	return result, nil
+}
+
+// GetBindRequest returns a KAI Scheduler BindRequest, it has the name of the pod it binds
+func (c Client) GetBindRequest(namespace, name string) (*models.BindRequest, error) {
+	br, err := c.KAIBindRequestClient.BindRequests(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting bind request: %w", err)
+	}
+
+	result := toBindRequestModel(br)
+	return &result, nil
+}
+
+func toBindRequestModel(br *kaiSchedulingV1alpha2.BindRequest) models.BindRequest {
+	result := models.BindRequest{
+		BackoffLimit:         br.Spec.BackoffLimit,
+		CreationTimestamp:    br.CreationTimestamp.Format("2006-01-02T15:04:05Z"),
+		FailedAttempts:       br.Status.FailedAttempts,
+		Name:                 br.Name,
+		Namespace:            br.Namespace,
+		Node:                 br.Spec.SelectedNode,
+		Phase:                br.Status.Phase,
+		Pod:                  br.Spec.PodName,
+		Reason:               br.Status.Reason,
+		ReceivedResourceType: br.Spec.ReceivedResourceType,
+		SelectedGPUGroups:    br.Spec.SelectedGPUGroups,
+	}
+	if br.Spec.ReceivedGPU != nil {
+		result.ReceivedGPU = &models.BindRequestGPU{
+			Count:   br.Spec.ReceivedGPU.Count,
+			Portion: br.Spec.ReceivedGPU.Portion,
+		}
+	}
+	return result
+}
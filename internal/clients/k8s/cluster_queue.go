@@ -0,0 +1,336 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+func (c Client) ListClusterQueues() ([]models.ClusterQueue, error) {
+	list, err := c.KueueClientset.KueueV1beta2().ClusterQueues().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing cluster queues: %w", err)
+	}
+
+	var result []models.ClusterQueue
+	for _, cq := range list.Items {
+		result = append(result, toClusterQueueModel(&cq))
+	}
+	return result, nil
+}
+
+func (c Client) GetClusterQueue(name string) (*models.ClusterQueue, error) {
+	cq, err := c.KueueClientset.KueueV1beta2().ClusterQueues().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting cluster queue: %w", err)
+	}
+
+	result := toClusterQueueModel(cq)
+	return &result, nil
+}
+
+func (c Client) CreateClusterQueue(cq models.ClusterQueue) (*models.ClusterQueue, error) {
+	obj := &kueuev1beta2.ClusterQueue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: cq.Name,
+		},
+	}
+	if err := setClusterQueueSpec(&obj.Spec, cq); err != nil {
+		return nil, err
+	}
+
+	created, err := c.KueueClientset.KueueV1beta2().ClusterQueues().Create(context.TODO(), obj, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating cluster queue: %w", err)
+	}
+
+	result := toClusterQueueModel(created)
+	return &result, nil
+}
+
+// UpdateClusterQueue replaces the spec fields managed by the service, fields it does not manage are kept.
+// A resourceVersion that is no longer current fails with a conflict.
+func (c Client) UpdateClusterQueue(cq models.ClusterQueue) (*models.ClusterQueue, error) {
+	obj, err := c.KueueClientset.KueueV1beta2().ClusterQueues().Get(context.TODO(), cq.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting cluster queue: %w", err)
+	}
+
+	if cq.ResourceVersion != "" {
+		obj.ResourceVersion = cq.ResourceVersion
+	}
+
+	if err := setClusterQueueSpec(&obj.Spec, cq); err != nil {
+		return nil, err
+	}
+
+	updated, err := c.KueueClientset.KueueV1beta2().ClusterQueues().Update(context.TODO(), obj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed updating cluster queue: %w", err)
+	}
+
+	result := toClusterQueueModel(updated)
+	return &result, nil
+}
+
+// SetClusterQueueAdmissionChecks replaces the admission checks of a cluster queue and leaves the rest of its spec unchanged
+func (c Client) SetClusterQueueAdmissionChecks(name string, checks []models.ClusterQueueAdmissionCheck) (*models.ClusterQueue, error) {
+	obj, err := c.KueueClientset.KueueV1beta2().ClusterQueues().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting cluster queue: %w", err)
+	}
+
+	obj.Spec.AdmissionChecksStrategy = toAdmissionChecksStrategy(checks)
+
+	updated, err := c.KueueClientset.KueueV1beta2().ClusterQueues().Update(context.TODO(), obj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed updating cluster queue: %w", err)
+	}
+
+	result := toClusterQueueModel(updated)
+	return &result, nil
+}
+
+func (c Client) DeleteClusterQueue(name string) error {
+	err := c.KueueClientset.KueueV1beta2().ClusterQueues().Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed deleting cluster queue: %w", err)
+	}
+	return nil
+}
+
+func setClusterQueueSpec(spec *kueuev1beta2.ClusterQueueSpec, cq models.ClusterQueue) error {
+	spec.CohortName = kueuev1beta2.CohortReference(cq.Cohort)
+	spec.QueueingStrategy = kueuev1beta2.QueueingStrategy(cq.QueueingStrategy)
+	spec.NamespaceSelector = toLabelSelector(cq.NamespaceSelector)
+	spec.AdmissionChecksStrategy = toAdmissionChecksStrategy(cq.AdmissionChecks)
+
+	spec.StopPolicy = nil
+	if cq.StopPolicy != "" {
+		sp := kueuev1beta2.StopPolicy(cq.StopPolicy)
+		spec.StopPolicy = &sp
+	}
+
+	spec.Preemption = nil
+	if cq.Preemption != nil {
+		spec.Preemption = &kueuev1beta2.ClusterQueuePreemption{
+			ReclaimWithinCohort: kueuev1beta2.PreemptionPolicy(cq.Preemption.ReclaimWithinCohort),
+			WithinClusterQueue:  kueuev1beta2.PreemptionPolicy(cq.Preemption.WithinClusterQueue),
+		}
+		if b := cq.Preemption.BorrowWithinCohort; b != nil {
+			spec.Preemption.BorrowWithinCohort = &kueuev1beta2.BorrowWithinCohort{
+				MaxPriorityThreshold: b.MaxPriorityThreshold,
+				Policy:               kueuev1beta2.BorrowWithinCohortPolicy(b.Policy),
+			}
+		}
+	}
+
+	groups, err := toResourceGroups(cq.ResourceGroups)
+	if err != nil {
+		return err
+	}
+	spec.ResourceGroups = groups
+
+	return nil
+}
+
+func toResourceGroups(groups []models.ResourceGroup) ([]kueuev1beta2.ResourceGroup, error) {
+	var result []kueuev1beta2.ResourceGroup
+	for _, rg := range groups {
+		group := kueuev1beta2.ResourceGroup{}
+		for _, r := range rg.CoveredResources {
+			group.CoveredResources = append(group.CoveredResources, corev1.ResourceName(r))
+		}
+		for _, f := range rg.Flavors {
+			fq := kueuev1beta2.FlavorQuotas{Name: kueuev1beta2.ResourceFlavorReference(f.Name)}
+			for _, r := range f.Resources {
+				rq, err := toResourceQuota(f.Name, r)
+				if err != nil {
+					return nil, err
+				}
+				fq.Resources = append(fq.Resources, rq)
+			}
+			group.Flavors = append(group.Flavors, fq)
+		}
+		result = append(result, group)
+	}
+	return result, nil
+}
+
+func toResourceQuota(flavor string, r models.ResourceQuota) (kueuev1beta2.ResourceQuota, error) {
+	rq := kueuev1beta2.ResourceQuota{Name: corev1.ResourceName(r.Name)}
+
+	q, err := resource.ParseQuantity(r.NominalQuota)
+	if err != nil {
+		return rq, fmt.Errorf("invalid nominal quota for %s in flavor %s: %w", r.Name, flavor, err)
+	}
+	rq.NominalQuota = q
+
+	if r.BorrowingLimit != "" {
+		q, err := resource.ParseQuantity(r.BorrowingLimit)
+		if err != nil {
+			return rq, fmt.Errorf("invalid borrowing limit for %s in flavor %s: %w", r.Name, flavor, err)
+		}
+		rq.BorrowingLimit = &q
+	}
+
+	if r.LendingLimit != "" {
+		q, err := resource.ParseQuantity(r.LendingLimit)
+		if err != nil {
+			return rq, fmt.Errorf("invalid lending limit for %s in flavor %s: %w", r.Name, flavor, err)
+		}
+		rq.LendingLimit = &q
+	}
+
+	return rq, nil
+}
+
+func toAdmissionChecksStrategy(checks []models.ClusterQueueAdmissionCheck) *kueuev1beta2.AdmissionChecksStrategy {
+	if len(checks) == 0 {
+		return nil
+	}
+
+	result := &kueuev1beta2.AdmissionChecksStrategy{}
+	for _, ac := range checks {
+		rule := kueuev1beta2.AdmissionCheckStrategyRule{Name: kueuev1beta2.AdmissionCheckReference(ac.Name)}
+		for _, f := range ac.OnFlavors {
+			rule.OnFlavors = append(rule.OnFlavors, kueuev1beta2.ResourceFlavorReference(f))
+		}
+		result.AdmissionChecks = append(result.AdmissionChecks, rule)
+	}
+	return result
+}
+
+func toLabelSelector(s *models.LabelSelector) *metav1.LabelSelector {
+	if s == nil {
+		return nil
+	}
+
+	result := &metav1.LabelSelector{MatchLabels: s.MatchLabels}
+	for _, e := range s.MatchExpressions {
+		result.MatchExpressions = append(result.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      e.Key,
+			Operator: metav1.LabelSelectorOperator(e.Operator),
+			Values:   e.Values,
+		})
+	}
+	return result
+}
+
+func toLabelSelectorModel(s *metav1.LabelSelector) *models.LabelSelector {
+	if s == nil {
+		return nil
+	}
+
+	result := &models.LabelSelector{MatchLabels: s.MatchLabels}
+	for _, e := range s.MatchExpressions {
+		result.MatchExpressions = append(result.MatchExpressions, models.LabelSelectorRequirement{
+			Key:      e.Key,
+			Operator: string(e.Operator),
+			Values:   e.Values,
+		})
+	}
+	return result
+}
+
+func toClusterQueueModel(cq *kueuev1beta2.ClusterQueue) models.ClusterQueue {
+	m := models.ClusterQueue{
+		AdmittedWorkloads:  cq.Status.AdmittedWorkloads,
+		Cohort:             string(cq.Spec.CohortName),
+		Conditions:         toConditionModels(cq.Status.Conditions),
+		FlavorsReservation: toClusterQueueFlavorUsageModels(cq.Status.FlavorsReservation),
+		FlavorsUsage:       toClusterQueueFlavorUsageModels(cq.Status.FlavorsUsage),
+		Name:               cq.Name,
+		NamespaceSelector:  toLabelSelectorModel(cq.Spec.NamespaceSelector),
+		PendingWorkloads:   cq.Status.PendingWorkloads,
+		QueueingStrategy:   string(cq.Spec.QueueingStrategy),
+		ReservingWorkloads: cq.Status.ReservingWorkloads,
+		ResourceVersion:    cq.ResourceVersion,
+	}
+
+	if cq.Spec.StopPolicy != nil {
+		m.StopPolicy = string(*cq.Spec.StopPolicy)
+	}
+
+	if p := cq.Spec.Preemption; p != nil {
+		m.Preemption = &models.ClusterQueuePreemption{
+			ReclaimWithinCohort: string(p.ReclaimWithinCohort),
+			WithinClusterQueue:  string(p.WithinClusterQueue),
+		}
+		if b := p.BorrowWithinCohort; b != nil {
+			m.Preemption.BorrowWithinCohort = &models.BorrowWithinCohort{
+				MaxPriorityThreshold: b.MaxPriorityThreshold,
+				Policy:               string(b.Policy),
+			}
+		}
+	}
+
+	if s := cq.Spec.AdmissionChecksStrategy; s != nil {
+		for _, rule := range s.AdmissionChecks {
+			ac := models.ClusterQueueAdmissionCheck{Name: string(rule.Name)}
+			for _, f := range rule.OnFlavors {
+				ac.OnFlavors = append(ac.OnFlavors, string(f))
+			}
+			m.AdmissionChecks = append(m.AdmissionChecks, ac)
+		}
+	}
+
+	m.ResourceGroups = toResourceGroupModels(cq.Spec.ResourceGroups)
+
+	return m
+}
+
+func toResourceGroupModels(groups []kueuev1beta2.ResourceGroup) []models.ResourceGroup {
+	var result []models.ResourceGroup
+	for _, rg := range groups {
+		group := models.ResourceGroup{}
+		for _, r := range rg.CoveredResources {
+			group.CoveredResources = append(group.CoveredResources, string(r))
+		}
+		for _, f := range rg.Flavors {
+			fq := models.FlavorQuotas{Name: string(f.Name)}
+			for _, r := range f.Resources {
+				rq := models.ResourceQuota{
+					Name:         string(r.Name),
+					NominalQuota: r.NominalQuota.String(),
+				}
+				if r.BorrowingLimit != nil {
+					rq.BorrowingLimit = r.BorrowingLimit.String()
+				}
+				if r.LendingLimit != nil {
+					rq.LendingLimit = r.LendingLimit.String()
+				}
+				fq.Resources = append(fq.Resources, rq)
+			}
+			group.Flavors = append(group.Flavors, fq)
+		}
+		result = append(result, group)
+	}
+	return result
+}
+
+func toClusterQueueFlavorUsageModels(usage []kueuev1beta2.FlavorUsage) []models.FlavorUsage {
+	var result []models.FlavorUsage
+	for _, f := range usage {
+		fu := models.FlavorUsage{
+			Name: string(f.Name),
+		}
+		for _, r := range f.Resources {
+			ru := models.ResourceUsage{
+				Name:  string(r.Name),
+				Total: r.Total.String(),
+			}
+			if !r.Borrowed.IsZero() {
+				ru.Borrowed = r.Borrowed.String()
+			}
+			fu.Resources = append(fu.Resources, ru)
+		}
+		result = append(result, fu)
+	}
+	return result
+}
@@ -0,0 +1,105 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+func (c Client) ListCohorts() ([]models.Cohort, error) {
+	list, err := c.KueueClientset.KueueV1beta2().Cohorts().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing cohorts: %w", err)
+	}
+
+	var result []models.Cohort
+	for _, co := range list.Items {
+		result = append(result, toCohortModel(&co))
+	}
+	return result, nil
+}
+
+func (c Client) GetCohort(name string) (*models.Cohort, error) {
+	co, err := c.KueueClientset.KueueV1beta2().Cohorts().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting cohort: %w", err)
+	}
+
+	result := toCohortModel(co)
+	return &result, nil
+}
+
+func (c Client) CreateCohort(co models.Cohort) (*models.Cohort, error) {
+	obj := &kueuev1beta2.Cohort{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: co.Name,
+		},
+	}
+	if err := setCohortSpec(&obj.Spec, co); err != nil {
+		return nil, err
+	}
+
+	created, err := c.KueueClientset.KueueV1beta2().Cohorts().Create(context.TODO(), obj, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating cohort: %w", err)
+	}
+
+	result := toCohortModel(created)
+	return &result, nil
+}
+
+// UpdateCohort replaces the parent and resource groups of a cohort, fair sharing settings are kept.
+// A resourceVersion that is no longer current fails with a conflict.
+func (c Client) UpdateCohort(co models.Cohort) (*models.Cohort, error) {
+	obj, err := c.KueueClientset.KueueV1beta2().Cohorts().Get(context.TODO(), co.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting cohort: %w", err)
+	}
+
+	if co.ResourceVersion != "" {
+		obj.ResourceVersion = co.ResourceVersion
+	}
+
+	if err := setCohortSpec(&obj.Spec, co); err != nil {
+		return nil, err
+	}
+
+	updated, err := c.KueueClientset.KueueV1beta2().Cohorts().Update(context.TODO(), obj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed updating cohort: %w", err)
+	}
+
+	result := toCohortModel(updated)
+	return &result, nil
+}
+
+func (c Client) DeleteCohort(name string) error {
+	err := c.KueueClientset.KueueV1beta2().Cohorts().Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed deleting cohort: %w", err)
+	}
+	return nil
+}
+
+func setCohortSpec(spec *kueuev1beta2.CohortSpec, co models.Cohort) error {
+	groups, err := toResourceGroups(co.ResourceGroups)
+	if err != nil {
+		return err
+	}
+
+	spec.ParentName = kueuev1beta2.CohortReference(co.Parent)
+	spec.ResourceGroups = groups
+	return nil
+}
+
+func toCohortModel(co *kueuev1beta2.Cohort) models.Cohort {
+	return models.Cohort{
+		Name:            co.Name,
+		Parent:          string(co.Spec.ParentName),
+		ResourceGroups:  toResourceGroupModels(co.Spec.ResourceGroups),
+		ResourceVersion: co.ResourceVersion,
+	}
+}
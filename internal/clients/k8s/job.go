@@ -0,0 +1,590 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"fmt"
+	"strings"
+
+	kaiSchedulingV2alpha2 "github.com/kai-scheduler/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+const (
+	jobContainerName        = "job-container"
+	jobNameLabel            = "batch.kubernetes.io/job-name"
+	kaiPodGroupAnnotation   = "pod-group-name"
+	kaiQueueLabel           = "kai.scheduler/queue"
+	kaiSchedulerName        = "kai-scheduler"
+	kueuePriorityClassLabel = "kueue.x-k8s.io/priority-class"
+	kueueQueueNameLabel     = "kueue.x-k8s.io/queue-name"
+)
+
+// ListJobs returns jobs, optionally filtered by namespace, Kueue LocalQueue or KAI Scheduler queue, and state
+func (c Client) ListJobs(namespace, queue, state string) ([]models.JobDetail, error) {
+	list, err := c.Clientset.BatchV1().Jobs(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing jobs: %w", err)
+	}
+
+	workloads, err := c.KueueClientset.KueueV1beta2().Workloads(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing workloads: %w", err)
+	}
+
+	byOwner := make(map[types.UID]*kueuev1beta2.Workload)
+	for i := range workloads.Items {
+		for _, or := range workloads.Items[i].OwnerReferences {
+			byOwner[or.UID] = &workloads.Items[i]
+		}
+	}
+
+	var result []models.JobDetail
+	for _, j := range list.Items {
+		d := toJobDetailModel(&j, byOwner[j.UID])
+		if queue != "" && d.Spec.Queue != queue {
+			continue
+		}
+		if state != "" && !strings.EqualFold(d.Status.State, state) {
+			continue
+		}
+		result = append(result, d)
+	}
+	return result, nil
+}
+
+// GetJob returns a job with its Kueue admission state and the pods it owns
+func (c Client) GetJob(namespace, name string) (*models.JobDetail, error) {
+	job, err := c.Clientset.BatchV1().Jobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting job: %w", err)
+	}
+
+	wl, err := c.getJobWorkload(job)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := c.listJobPods(job)
+	if err != nil {
+		return nil, err
+	}
+
+	result := toJobDetailModel(job, wl)
+	for _, p := range pods {
+		result.Pods = append(result.Pods, toPodModel(p))
+	}
+	return &result, nil
+}
+
+// CreateJob creates a job in a Kueue LocalQueue, or in a KAI Scheduler leaf queue with a PodGroup for multi-pod jobs
+func (c Client) CreateJob(job models.Job) (*models.Job, error) {
+	if job.Scheduler == models.JobSchedulerKAI {
+		if err := c.checkKaiSchedulerLeafQueue(job.Queue); err != nil {
+			return nil, err
+		}
+	}
+
+	if job.PriorityClass != "" {
+		if err := c.checkWorkloadPriorityClass(job.PriorityClass); err != nil {
+			return nil, err
+		}
+	}
+
+	obj, err := toJobObject(job)
+	if err != nil {
+		return nil, err
+	}
+
+	// The PodGroup is owned by the job, so a gang scheduled job is created suspended and only unsuspended once its
+	// PodGroup exists, otherwise the scheduler could see its pods first and schedule them one by one
+	gang := job.Scheduler == models.JobSchedulerKAI && job.Parallelism != nil && *job.Parallelism > 1
+	if gang {
+		suspend := true
+		obj.Spec.Suspend = &suspend
+	}
+
+	created, err := c.Clientset.BatchV1().Jobs(job.Namespace).Create(context.TODO(), obj, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating job: %w", err)
+	}
+
+	if gang {
+		_, err = c.KAIPodGroupClient.PodGroups(job.Namespace).Create(context.TODO(), toKaiPodGroupObject(created), metav1.CreateOptions{})
+		if err != nil {
+			err = fmt.Errorf("failed creating pod group: %w", err)
+		} else {
+			created, err = c.Clientset.BatchV1().Jobs(job.Namespace).Patch(context.TODO(), job.Name, types.MergePatchType, []byte(`{"spec":{"suspend":false}}`), metav1.PatchOptions{})
+			if err != nil {
+				err = fmt.Errorf("failed unsuspending job: %w", err)
+			}
+		}
+		if err != nil {
+			// Without its PodGroup the job cannot be gang scheduled, so remove it rather than leave it half submitted
+			policy := metav1.DeletePropagationBackground
+			_ = c.Clientset.BatchV1().Jobs(job.Namespace).Delete(context.TODO(), job.Name, metav1.DeleteOptions{PropagationPolicy: &policy})
+			return nil, err
+		}
+	}
+
+	result := toJobModel(created)
+	return &result, nil
+}
+
+// DeleteJob deletes a job using the given propagation policy for its pods
+func (c Client) DeleteJob(namespace, name, propagationPolicy string) error {
+	policy := metav1.DeletionPropagation(propagationPolicy)
+	err := c.Clientset.BatchV1().Jobs(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{PropagationPolicy: &policy})
+	if err != nil {
+		return fmt.Errorf("failed deleting job: %w", err)
+	}
+	return nil
+}
+
+// SuspendJob deactivates the job's Kueue Workload, which evicts it and releases its quota.
+// Jobs that are not managed by Kueue are suspended directly.
+func (c Client) SuspendJob(namespace, name string) (*models.JobAdmission, error) {
+	return c.setJobActive(namespace, name, false)
+}
+
+// ResumeJob reactivates the job's Kueue Workload so it queues for admission again.
+// Jobs that are not managed by Kueue are unsuspended directly.
+func (c Client) ResumeJob(namespace, name string) (*models.JobAdmission, error) {
+	return c.setJobActive(namespace, name, true)
+}
+
+// RequeueJob deletes the job's Kueue Workload, Kueue suspends the job and queues a new Workload for it.
+// The job is returned as pending without waiting for the new Workload.
+func (c Client) RequeueJob(namespace, name string) (*models.JobAdmission, error) {
+	job, err := c.Clientset.BatchV1().Jobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting job: %w", err)
+	}
+	if _, managed := job.Labels[kueueQueueNameLabel]; !managed {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("job '%s' is not managed by Kueue and cannot be requeued", name))
+	}
+
+	wl, err := c.getJobWorkload(job)
+	if err != nil {
+		return nil, err
+	}
+	if wl == nil {
+		return nil, apierrors.NewConflict(kueuev1beta2.Resource("workloads"), name, fmt.Errorf("kueue has not created a workload for the job yet"))
+	}
+
+	policy := metav1.DeletePropagationBackground
+	err = c.KueueClientset.KueueV1beta2().Workloads(namespace).Delete(context.TODO(), wl.Name, metav1.DeleteOptions{PropagationPolicy: &policy})
+	if err != nil {
+		return nil, fmt.Errorf("failed deleting workload: %w", err)
+	}
+
+	// Kueue creates the replacement Workload asynchronously, the job is pending until it is admitted again
+	return &models.JobAdmission{Active: true, State: "Pending"}, nil
+}
+
+func (c Client) setJobActive(namespace, name string, active bool) (*models.JobAdmission, error) {
+	job, err := c.Clientset.BatchV1().Jobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting job: %w", err)
+	}
+
+	if _, managed := job.Labels[kueueQueueNameLabel]; !managed {
+		patch := fmt.Appendf(nil, `{"spec":{"suspend":%t}}`, !active)
+		_, err := c.Clientset.BatchV1().Jobs(namespace).Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed patching job: %w", err)
+		}
+		state := "Suspended"
+		if active {
+			state = "Pending"
+		}
+		return &models.JobAdmission{Active: active, State: state}, nil
+	}
+
+	// Kueue owns spec.suspend of the jobs it manages, so the Workload is patched instead
+	wl, err := c.getJobWorkload(job)
+	if err != nil {
+		return nil, err
+	}
+	if wl == nil {
+		return nil, apierrors.NewConflict(kueuev1beta2.Resource("workloads"), name, fmt.Errorf("kueue has not created a workload for the job yet"))
+	}
+
+	patch := fmt.Appendf(nil, `{"spec":{"active":%t}}`, active)
+	patched, err := c.KueueClientset.KueueV1beta2().Workloads(namespace).Patch(context.TODO(), wl.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed patching workload: %w", err)
+	}
+	return toJobAdmissionModel(patched), nil
+}
+
+// getJobWorkload returns the Kueue Workload owned by the job, or nil when Kueue has not created one
+func (c Client) getJobWorkload(job *batchv1.Job) (*kueuev1beta2.Workload, error) {
+	workloads, err := c.KueueClientset.KueueV1beta2().Workloads(job.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing workloads: %w", err)
+	}
+
+	for i := range workloads.Items {
+		for _, or := range workloads.Items[i].OwnerReferences {
+			if or.UID == job.UID {
+				return &workloads.Items[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// checkKaiSchedulerLeafQueue ensures jobs are only submitted to KAI Scheduler queues without children
+func (c Client) checkKaiSchedulerLeafQueue(name string) error {
+	list, err := c.KAISchedulerClient.Queues("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed listing kai scheduler queues: %w", err)
+	}
+
+	found := false
+	for _, q := range list.Items {
+		if q.Name == name {
+			found = true
+		}
+		if q.Spec.ParentQueue == name {
+			return apierrors.NewBadRequest(fmt.Sprintf("kai scheduler queue '%s' is a parent queue, jobs must be submitted to a leaf queue", name))
+		}
+	}
+
+	if !found {
+		return apierrors.NewBadRequest(fmt.Sprintf("kai scheduler queue '%s' does not exist", name))
+	}
+	return nil
+}
+
+func (c Client) listJobPods(job *batchv1.Job) ([]corev1.Pod, error) {
+	selector := labels.Set{jobNameLabel: job.Name}.String()
+	if job.Spec.Selector != nil {
+		s, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid job selector: %w", err)
+		}
+		selector = s.String()
+	}
+
+	pods, err := c.Clientset.CoreV1().Pods(job.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing job pods: %w", err)
+	}
+	return pods.Items, nil
+}
+
+// checkWorkloadPriorityClass ensures a job's priority class exists, Kueue would otherwise not create its Workload
+func (c Client) checkWorkloadPriorityClass(name string) error {
+	_, err := c.KueueClientset.KueueV1beta2().WorkloadPriorityClasses().Get(context.TODO(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return apierrors.NewBadRequest(fmt.Sprintf("workload priority class '%s' does not exist", name))
+	}
+	if err != nil {
+		return fmt.Errorf("failed getting workload priority class: %w", err)
+	}
+	return nil
+}
+
+// toJobObject builds a suspended batch/v1 Job labelled for the Kueue LocalQueue, Kueue unsuspends it on admission.
+// KAI Scheduler jobs are not suspended, their pods are held by the scheduler until the queue can fit them.
+// CreateJob suspends the gang scheduled ones itself until their PodGroup exists.
+func toJobObject(job models.Job) (*batchv1.Job, error) {
+	resources, err := toResourceRequirements(job.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	container := corev1.Container{
+		Name:      jobContainerName,
+		Image:     job.Image,
+		Command:   job.Command,
+		Args:      job.Args,
+		Resources: resources,
+	}
+	for _, e := range job.Env {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  e.Name,
+			Value: e.Value,
+		})
+	}
+
+	suspend := true
+	obj := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name,
+			Namespace: job.Namespace,
+			Labels: map[string]string{
+				kueueQueueNameLabel: job.Queue,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: job.BackoffLimit,
+			Completions:  job.Completions,
+			Parallelism:  job.Parallelism,
+			Suspend:      &suspend,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "job",
+					},
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers:    []corev1.Container{container},
+				},
+			},
+		},
+	}
+
+	if job.PriorityClass != "" {
+		obj.Labels[kueuePriorityClassLabel] = job.PriorityClass
+	}
+
+	if job.Scheduler == models.JobSchedulerKAI {
+		obj.Labels = map[string]string{kaiQueueLabel: job.Queue}
+		obj.Spec.Suspend = nil
+		obj.Spec.Template.Labels[kaiQueueLabel] = job.Queue
+		obj.Spec.Template.Spec.SchedulerName = kaiSchedulerName
+		if job.Parallelism != nil && *job.Parallelism > 1 {
+			obj.Spec.Template.Annotations = map[string]string{kaiPodGroupAnnotation: job.Name}
+		}
+	}
+
+	return obj, nil
+}
+
+// toKaiPodGroupObject builds the PodGroup that gang schedules all pods of a parallel job, owned by the job so it is removed with it
+func toKaiPodGroupObject(job *batchv1.Job) *kaiSchedulingV2alpha2.PodGroup {
+	return &kaiSchedulingV2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name,
+			Namespace: job.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(job, batchv1.SchemeGroupVersion.WithKind("Job")),
+			},
+		},
+		Spec: kaiSchedulingV2alpha2.PodGroupSpec{
+			MinMember: *job.Spec.Parallelism,
+			Queue:     job.Labels[kaiQueueLabel],
+		},
+	}
+}
+
+func toResourceRequirements(r models.ResourceRequirements) (corev1.ResourceRequirements, error) {
+	var result corev1.ResourceRequirements
+
+	if len(r.Requests) > 0 {
+		result.Requests = make(corev1.ResourceList, len(r.Requests))
+		for name, value := range r.Requests {
+			q, err := resource.ParseQuantity(value)
+			if err != nil {
+				return result, fmt.Errorf("invalid request for %s: %w", name, err)
+			}
+			result.Requests[corev1.ResourceName(name)] = q
+		}
+	}
+
+	if len(r.Limits) > 0 {
+		result.Limits = make(corev1.ResourceList, len(r.Limits))
+		for name, value := range r.Limits {
+			q, err := resource.ParseQuantity(value)
+			if err != nil {
+				return result, fmt.Errorf("invalid limit for %s: %w", name, err)
+			}
+			result.Limits[corev1.ResourceName(name)] = q
+		}
+	}
+
+	// Extended resources such as GPUs cannot be overcommitted, so the limit must equal the request
+	for name, q := range result.Requests {
+		if isExtendedResource(name) {
+			if _, exists := result.Limits[name]; !exists {
+				if result.Limits == nil {
+					result.Limits = make(corev1.ResourceList)
+				}
+				result.Limits[name] = q
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func isExtendedResource(name corev1.ResourceName) bool {
+	switch name {
+	case corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourceEphemeralStorage:
+		return false
+	}
+	return true
+}
+
+func toJobModel(job *batchv1.Job) models.Job {
+	m := models.Job{
+		BackoffLimit:  job.Spec.BackoffLimit,
+		Completions:   job.Spec.Completions,
+		Name:          job.Name,
+		Namespace:     job.Namespace,
+		Parallelism:   job.Spec.Parallelism,
+		PriorityClass: job.Labels[kueuePriorityClassLabel],
+		Queue:         job.Labels[kueueQueueNameLabel],
+		Scheduler:     models.JobSchedulerKueue,
+	}
+
+	if job.Spec.Template.Spec.SchedulerName == kaiSchedulerName {
+		m.Queue = job.Labels[kaiQueueLabel]
+		m.Scheduler = models.JobSchedulerKAI
+	}
+
+	if len(job.Spec.Template.Spec.Containers) > 0 {
+		container := job.Spec.Template.Spec.Containers[0]
+		m.Args = container.Args
+		m.Command = container.Command
+		m.Image = container.Image
+		for _, e := range container.Env {
+			if e.ValueFrom == nil {
+				m.Env = append(m.Env, models.EnvVar{
+					Name:  e.Name,
+					Value: e.Value,
+				})
+			}
+		}
+		m.Resources = toResourceRequirementsModel(container.Resources)
+	}
+
+	return m
+}
+
+func toResourceRequirementsModel(r corev1.ResourceRequirements) models.ResourceRequirements {
+	var m models.ResourceRequirements
+	if len(r.Requests) > 0 {
+		m.Requests = make(map[string]string, len(r.Requests))
+		for name, q := range r.Requests {
+			m.Requests[string(name)] = q.String()
+		}
+	}
+	if len(r.Limits) > 0 {
+		m.Limits = make(map[string]string, len(r.Limits))
+		for name, q := range r.Limits {
+			m.Limits[string(name)] = q.String()
+		}
+	}
+	return m
+}
+
+func toJobDetailModel(job *batchv1.Job, wl *kueuev1beta2.Workload) models.JobDetail {
+	m := models.JobDetail{
+		CreationTimestamp: job.CreationTimestamp.Format("2006-01-02T15:04:05Z"),
+		Spec:              toJobModel(job),
+		Status: models.JobStatus{
+			Active:    job.Status.Active,
+			Failed:    job.Status.Failed,
+			Succeeded: job.Status.Succeeded,
+			Suspended: job.Spec.Suspend != nil && *job.Spec.Suspend,
+		},
+		UID: string(job.UID),
+	}
+
+	if job.Status.StartTime != nil {
+		m.Status.StartTime = job.Status.StartTime.Format("2006-01-02T15:04:05Z")
+	}
+	if job.Status.CompletionTime != nil {
+		m.Status.CompletionTime = job.Status.CompletionTime.Format("2006-01-02T15:04:05Z")
+	}
+
+	var complete, failed bool
+	for _, c := range job.Status.Conditions {
+		if c.Status == corev1.ConditionTrue {
+			switch c.Type {
+			case batchv1.JobComplete:
+				complete = true
+			case batchv1.JobFailed:
+				failed = true
+			}
+		}
+		m.Conditions = append(m.Conditions, models.Condition{
+			LastTransitionTime: c.LastTransitionTime.Format("2006-01-02T15:04:05Z"),
+			Message:            c.Message,
+			Reason:             c.Reason,
+			Status:             string(c.Status),
+			Type:               string(c.Type),
+		})
+	}
+
+	switch {
+	case complete:
+		m.Status.State = "Succeeded"
+	case failed:
+		m.Status.State = "Failed"
+	case m.Status.Suspended:
+		m.Status.State = "Suspended"
+	case job.Status.Active > 0:
+		m.Status.State = "Running"
+	default:
+		m.Status.State = "Pending"
+	}
+
+	if wl != nil {
+		m.Admission = toJobAdmissionModel(wl)
+	}
+
+	return m
+}
+
+func toJobAdmissionModel(wl *kueuev1beta2.Workload) *models.JobAdmission {
+	m := &models.JobAdmission{
+		Active:     wl.Spec.Active == nil || *wl.Spec.Active,
+		Conditions: toConditionModels(wl.Status.Conditions),
+		State:      toWorkloadState(wl.Status.Conditions),
+		Workload:   wl.Name,
+	}
+	if wl.Status.Admission != nil {
+		m.ClusterQueue = string(wl.Status.Admission.ClusterQueue)
+	}
+	return m
+}
+
+// toWorkloadState summarises the Kueue Workload conditions into a single admission state
+func toWorkloadState(conditions []metav1.Condition) string {
+	isTrue := func(t string) bool {
+		c := meta.FindStatusCondition(conditions, t)
+		return c != nil && c.Status == metav1.ConditionTrue
+	}
+
+	switch {
+	case isTrue(kueuev1beta2.WorkloadFinished):
+		return kueuev1beta2.WorkloadFinished
+	case isTrue(kueuev1beta2.WorkloadEvicted):
+		return kueuev1beta2.WorkloadEvicted
+	case isTrue(kueuev1beta2.WorkloadAdmitted):
+		return kueuev1beta2.WorkloadAdmitted
+	case isTrue(kueuev1beta2.WorkloadQuotaReserved):
+		return kueuev1beta2.WorkloadQuotaReserved
+	default:
+		return "Pending"
+	}
+}
+
+func toConditionModels(conditions []metav1.Condition) []models.Condition {
+	var result []models.Condition
+	for _, c := range conditions {
+		result = append(result, models.Condition{
+			LastTransitionTime: c.LastTransitionTime.Format("2006-01-02T15:04:05Z"),
+			Message:            c.Message,
+			ObservedGeneration: c.ObservedGeneration,
+			Reason:             c.Reason,
+			Status:             string(c.Status),
+			Type:               c.Type,
+		})
+	}
+	return result
+}
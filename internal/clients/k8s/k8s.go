@@ -8,18 +8,24 @@ import (
 	"fmt"
 	"strings"
 
+	schedulingv1alpha2 "github.com/kai-scheduler/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v1alpha2"
 	schedulingv2 "github.com/kai-scheduler/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v2"
+	schedulingv2alpha2 "github.com/kai-scheduler/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v2alpha2"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 	kueueversioned "sigs.k8s.io/kueue/client-go/clientset/versioned"
 )
 
 type Client struct {
-	Clientset          *kubernetes.Clientset
-	EnvClient          *env.Client
-	KAISchedulerClient schedulingv2.SchedulingV2Interface
-	KueueClientset     kueueversioned.Interface
+	Clientset            *kubernetes.Clientset
+	EnvClient            *env.Client
+	KAIBindRequestClient schedulingv1alpha2.SchedulingV1alpha2Interface
+	KAIPodGroupClient    schedulingv2alpha2.SchedulingV2alpha2Interface
+	KAISchedulerClient   schedulingv2.SchedulingV2Interface
+	KueueClientset       kueueversioned.Interface
 }
 
 func New(envClient *env.Client, socks5Client *socks5.Client, kubeconfig string) (*Client, error) {
@@ -49,16 +55,18 @@ func New(envClient *env.Client, socks5Client *socks5.Client, kubeconfig string)
 		return nil, fmt.Errorf("failed creating kueue clientset: %w", err)
 	}
 
-	kaiSchedulerClient, err := newKAIClients(config)
+	kaiSchedulerClient, kaiPodGroupClient, kaiBindRequestClient, err := newKAIClients(config)
 	if err != nil {
 		return nil, fmt.Errorf("failed creating kai scheduler clientset: %w", err)
 	}
 
 	return &Client{
-		Clientset:          clientset,
-		EnvClient:          envClient,
-		KAISchedulerClient: kaiSchedulerClient,
-		KueueClientset:     kueueClientset,
+		Clientset:            clientset,
+		EnvClient:            envClient,
+		KAIBindRequestClient: kaiBindRequestClient,
+		KAIPodGroupClient:    kaiPodGroupClient,
+		KAISchedulerClient:   kaiSchedulerClient,
+		KueueClientset:       kueueClientset,
 	}, nil
 }
 
@@ -110,10 +118,29 @@ func (c Client) ListNodes() ([]models.Node, error) {
 			roleStr = "<none>"
 		}
 
+		allocatable := make(models.NodeResources)
+		for name, quantity := range n.Status.Allocatable {
+			allocatable[string(name)] = quantity.String()
+		}
+
+		capacity := make(models.NodeResources)
+		for name, quantity := range n.Status.Capacity {
+			capacity[string(name)] = quantity.String()
+		}
+
+		var taints []models.NodeTaint
+		for _, t := range n.Spec.Taints {
+			taints = append(taints, toNodeTaintModel(t))
+		}
+
 		result = append(result, models.Node{
+			Allocatable:    allocatable,
+			Capacity:       capacity,
+			Labels:         n.Labels,
 			Name:           n.Name,
 			Ready:          ready,
 			Roles:          roleStr,
+			Taints:         taints,
 			IP:             ip,
 			CPU:            n.Status.Capacity.Cpu().String(),
 			Memory:         n.Status.Capacity.Memory().String(),
@@ -154,11 +181,7 @@ func (c Client) GetNode(name string) (*models.NodeDetail, error) {
 
 	var taints []models.NodeTaint
 	for _, t := range node.Spec.Taints {
-		taints = append(taints, models.NodeTaint{
-			Key:    t.Key,
-			Value:  t.Value,
-			Effect: string(t.Effect),
-		})
+		taints = append(taints, toNodeTaintModel(t))
 	}
 
 	var addresses []models.NodeAddress
@@ -221,11 +244,12 @@ func (c Client) GetNode(name string) (*models.NodeDetail, error) {
 			OSImage:                 node.Status.NodeInfo.OSImage,
 			SystemUUID:              node.Status.NodeInfo.SystemUUID,
 		},
-		PodCIDR: node.Spec.PodCIDR,
-		Ready:   ready,
-		Roles:   roleStr,
-		Taints:  taints,
-		UID:     string(node.UID),
+		PodCIDR:       node.Spec.PodCIDR,
+		Ready:         ready,
+		Roles:         roleStr,
+		Taints:        taints,
+		UID:           string(node.UID),
+		Unschedulable: node.Spec.Unschedulable,
 	}, nil
 }
 
@@ -237,35 +261,66 @@ func (c Client) ListPods() ([]models.Pod, error) {
 
 	var result []models.Pod
 	for _, p := range podList.Items {
-		var restarts int
-		for _, cs := range p.Status.ContainerStatuses {
-			restarts += int(cs.RestartCount)
-		}
+		result = append(result, toPodModel(p))
+	}
 
-		statusClass := "pending"
-		switch strings.ToLower(string(p.Status.Phase)) {
-		case "running":
-			statusClass = "running"
-		case "succeeded":
-			statusClass = "ready"
-		case "failed":
-			statusClass = "notready"
-		}
+	return result, nil
+}
 
-		result = append(result, models.Pod{
-			Name:        p.Name,
-			Namespace:   p.Namespace,
-			Status:      string(p.Status.Phase),
-			StatusClass: statusClass,
-			Node:        p.Spec.NodeName,
-			PodIP:       p.Status.PodIP,
-			Restarts:    restarts,
-		})
+// ListPodsOnNode returns the pods bound to a node
+func (c Client) ListPodsOnNode(name string) ([]models.Pod, error) {
+	pods, err := c.nodePods(name)
+	if err != nil {
+		return nil, err
 	}
 
+	var result []models.Pod
+	for _, p := range pods {
+		result = append(result, toPodModel(p))
+	}
 	return result, nil
 }
 
+// nodePods lists the pods bound to a node with a field selector, so only the pods of that node are read
+func (c Client) nodePods(name string) ([]corev1.Pod, error) {
+	list, err := c.Clientset.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", name).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing node pods: %w", err)
+	}
+	return list.Items, nil
+}
+
+func toPodModel(p corev1.Pod) models.Pod {
+	var restarts int
+	for _, cs := range p.Status.ContainerStatuses {
+		restarts += int(cs.RestartCount)
+	}
+
+	statusClass := "pending"
+	switch strings.ToLower(string(p.Status.Phase)) {
+	case "running":
+		statusClass = "running"
+	case "succeeded":
+		statusClass = "ready"
+	case "failed":
+		statusClass = "notready"
+	}
+
+	return models.Pod{
+		Name:        p.Name,
+		Namespace:   p.Namespace,
+		Status:      string(p.Status.Phase),
+		StatusClass: statusClass,
+		Node:        p.Spec.NodeName,
+		PodIP:       p.Status.PodIP,
+		Limits:      toResourceListModel(podLimits(p.Spec)),
+		Requests:    toResourceListModel(podRequests(p.Spec)),
+		Restarts:    restarts,
+	}
+}
+
 //revive:disable:cyclomatic
 func (c Client) GetPod(namespace, name string) (*models.PodDetail, error) {
 	pod, err := c.Clientset.CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
@@ -2,14 +2,16 @@ package k8s
 
 import (
 	kaiClientset "github.com/kai-scheduler/KAI-scheduler/pkg/apis/client/clientset/versioned"
+	schedulingv1alpha2 "github.com/kai-scheduler/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v1alpha2"
 	schedulingv2 "github.com/kai-scheduler/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v2"
+	schedulingv2alpha2 "github.com/kai-scheduler/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v2alpha2"
 	"k8s.io/client-go/rest"
 )
 
-func newKAIClients(cfg *rest.Config) (schedulingv2.SchedulingV2Interface, error) {
+func newKAIClients(cfg *rest.Config) (schedulingv2.SchedulingV2Interface, schedulingv2alpha2.SchedulingV2alpha2Interface, schedulingv1alpha2.SchedulingV1alpha2Interface, error) {
 	cs, err := kaiClientset.NewForConfig(cfg)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
-	return cs.SchedulingV2(), nil
+	return cs.SchedulingV2(), cs.SchedulingV2alpha2(), cs.SchedulingV1alpha2(), nil
 }
@@ -52,11 +52,118 @@ func (c Client) GetKaiSchedulerChildQueues(parent string) ([]models.KaiScheduler
 	return result, nil
 }
 
+func (c Client) ListKaiSchedulerQueues() ([]models.KaiSchedulerQueue, error) {
+	list, err := c.KAISchedulerClient.Queues("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing kai scheduler queues: %w", err)
+	}
+
+	var result []models.KaiSchedulerQueue
+	for _, q := range list.Items {
+		result = append(result, toKaiSchedulerQueue(q))
+	}
+
+	return result, nil
+}
+
+func (c Client) GetKaiSchedulerQueue(name string) (*models.KaiSchedulerQueue, error) {
+	q, err := c.KAISchedulerClient.Queues("").Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting kai scheduler queue: %w", err)
+	}
+
+	result := toKaiSchedulerQueue(*q)
+	return &result, nil
+}
+
+func (c Client) CreateKaiSchedulerQueue(queue models.KaiSchedulerQueue) (*models.KaiSchedulerQueue, error) {
+	obj := &kaiSchedulingV2.Queue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: queue.Name,
+		},
+	}
+	setKaiSchedulerQueueSpec(&obj.Spec, queue)
+
+	created, err := c.KAISchedulerClient.Queues("").Create(context.TODO(), obj, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating kai scheduler queue: %w", err)
+	}
+
+	result := toKaiSchedulerQueue(*created)
+	return &result, nil
+}
+
+// UpdateKaiSchedulerQueue replaces the spec of a queue. A resourceVersion that is no longer current fails with a conflict.
+func (c Client) UpdateKaiSchedulerQueue(queue models.KaiSchedulerQueue) (*models.KaiSchedulerQueue, error) {
+	existing, err := c.KAISchedulerClient.Queues("").Get(context.TODO(), queue.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting kai scheduler queue: %w", err)
+	}
+
+	if queue.ResourceVersion != "" {
+		existing.ResourceVersion = queue.ResourceVersion
+	}
+	setKaiSchedulerQueueSpec(&existing.Spec, queue)
+
+	updated, err := c.KAISchedulerClient.Queues("").Update(context.TODO(), existing, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed updating kai scheduler queue: %w", err)
+	}
+
+	result := toKaiSchedulerQueue(*updated)
+	return &result, nil
+}
+
+func (c Client) DeleteKaiSchedulerQueue(name string) error {
+	err := c.KAISchedulerClient.Queues("").Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed deleting kai scheduler queue: %w", err)
+	}
+	return nil
+}
+
+// setKaiSchedulerQueueSpec replaces the parent, display name, priority and resources of a queue spec, other fields are kept
+func setKaiSchedulerQueueSpec(spec *kaiSchedulingV2.QueueSpec, queue models.KaiSchedulerQueue) {
+	spec.DisplayName = queue.DisplayName
+	spec.ParentQueue = queue.Parent
+	spec.Priority = queue.Priority
+
+	spec.Resources = &kaiSchedulingV2.QueueResources{
+		CPU: toKaiSchedulerQueueResourceObject(queue.Resources.Cpu),
+		GPU: toKaiSchedulerQueueResourceObject(queue.Resources.Gpu),
+	}
+	if queue.Resources.Memory != nil {
+		spec.Resources.Memory = toKaiSchedulerQueueResourceObject(*queue.Resources.Memory)
+	}
+}
+
+func toKaiSchedulerQueueResourceObject(r models.KaiSchedulerQueueResource) kaiSchedulingV2.QueueResource {
+	return kaiSchedulingV2.QueueResource{
+		Limit:           r.Limit,
+		OverQuotaWeight: r.OverQuotaWeight,
+		Quota:           r.Quota,
+	}
+}
+
+func toKaiSchedulerQueue(q kaiSchedulingV2.Queue) models.KaiSchedulerQueue {
+	return models.KaiSchedulerQueue{
+		ChildQueues:     q.Status.ChildQueues,
+		DisplayName:     q.Spec.DisplayName,
+		Name:            q.Name,
+		Parent:          q.Spec.ParentQueue,
+		Priority:        q.Spec.Priority,
+		ResourceVersion: q.ResourceVersion,
+		Resources:       toKaiSchedulerQueueResources(q.Spec.Resources),
+		Status:          toKaiSchedulerQueueStatus(q.Status),
+	}
+}
+
 func toKaiSchedulerParentQueue(q kaiSchedulingV2.Queue) models.KaiSchedulerParentQueue {
 	return models.KaiSchedulerParentQueue{
 		ChildQueues: q.Status.ChildQueues,
 		Name:        q.Name,
 		Resources:   toKaiSchedulerQueueResources(q.Spec.Resources),
+		Status:      toKaiSchedulerQueueStatus(q.Status),
 	}
 }
 
@@ -65,6 +172,7 @@ func toKaiSchedulerChildQueue(q kaiSchedulingV2.Queue) models.KaiSchedulerChildQ
 		Name:      q.Name,
 		Parent:    q.Spec.ParentQueue,
 		Resources: toKaiSchedulerQueueResources(q.Spec.Resources),
+		Status:    toKaiSchedulerQueueStatus(q.Status),
 	}
 }
 
@@ -87,3 +195,11 @@ func toKaiSchedulerQueueResource(r kaiSchedulingV2.QueueResource) models.KaiSche
 		Quota:           r.Quota,
 	}
 }
+
+func toKaiSchedulerQueueStatus(s kaiSchedulingV2.QueueStatus) *models.KaiSchedulerQueueStatus {
+	return &models.KaiSchedulerQueueStatus{
+		Allocated:               toResourceListModel(s.Allocated),
+		AllocatedNonPreemptible: toResourceListModel(s.AllocatedNonPreemptible),
+		Requested:               toResourceListModel(s.Requested),
+	}
+}
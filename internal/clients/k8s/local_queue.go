@@ -57,6 +57,32 @@ func (c Client) CreateLocalQueue(namespace string, lq models.LocalQueue) (*model
 	return &result, nil
 }
 
+// UpdateLocalQueue replaces the spec of a local queue. A resourceVersion that is no longer current fails with a conflict.
+func (c Client) UpdateLocalQueue(namespace string, lq models.LocalQueue) (*models.LocalQueue, error) {
+	obj, err := c.KueueClientset.KueueV1beta2().LocalQueues(namespace).Get(context.TODO(), lq.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting local queue: %w", err)
+	}
+
+	if lq.ResourceVersion != "" {
+		obj.ResourceVersion = lq.ResourceVersion
+	}
+	obj.Spec.ClusterQueue = kueuev1beta2.ClusterQueueReference(lq.ClusterQueue)
+	obj.Spec.StopPolicy = nil
+	if lq.StopPolicy != "" {
+		sp := kueuev1beta2.StopPolicy(lq.StopPolicy)
+		obj.Spec.StopPolicy = &sp
+	}
+
+	updated, err := c.KueueClientset.KueueV1beta2().LocalQueues(namespace).Update(context.TODO(), obj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed updating local queue: %w", err)
+	}
+
+	result := toLocalQueueModel(updated)
+	return &result, nil
+}
+
 func (c Client) DeleteLocalQueue(namespace, name string) error {
 	err := c.KueueClientset.KueueV1beta2().LocalQueues(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
 	if err != nil {
@@ -73,22 +99,14 @@ func toLocalQueueModel(lq *kueuev1beta2.LocalQueue) models.LocalQueue {
 		Namespace:          lq.Namespace,
 		PendingWorkloads:   lq.Status.PendingWorkloads,
 		ReservingWorkloads: lq.Status.ReservingWorkloads,
+		ResourceVersion:    lq.ResourceVersion,
 	}
 
 	if lq.Spec.StopPolicy != nil {
 		m.StopPolicy = string(*lq.Spec.StopPolicy)
 	}
 
-	for _, c := range lq.Status.Conditions {
-		m.Conditions = append(m.Conditions, models.Condition{
-			LastTransitionTime: c.LastTransitionTime.Format("2006-01-02T15:04:05Z"),
-			Message:            c.Message,
-			ObservedGeneration: c.ObservedGeneration,
-			Reason:             c.Reason,
-			Status:             string(c.Status),
-			Type:               c.Type,
-		})
-	}
+	m.Conditions = toConditionModels(lq.Status.Conditions)
 
 	for _, f := range lq.Status.FlavorsReservation {
 		fu := models.FlavorUsage{
@@ -0,0 +1,42 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"cmyk/internal/util"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// metricsAPIPath is served by metrics-server through the API aggregation layer, it is missing when metrics-server is not installed
+const metricsAPIPath = "/apis/metrics.k8s.io/v1beta1"
+
+// ListNodeMetrics returns the current usage of every node from the metrics API
+func (c Client) ListNodeMetrics() ([]models.NodeUsage, error) {
+	data, err := c.Clientset.Discovery().RESTClient().Get().AbsPath(metricsAPIPath, "nodes").DoRaw(context.TODO())
+	if err != nil {
+		return nil, fmt.Errorf("failed listing node metrics: %w", err)
+	}
+
+	var list models.NodeMetricsList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed decoding node metrics: %w", err)
+	}
+
+	return util.NodeUsages(list), nil
+}
+
+// ListPodMetrics returns the current usage of every pod from the metrics API, summed over its containers
+func (c Client) ListPodMetrics() ([]models.PodUsage, error) {
+	data, err := c.Clientset.Discovery().RESTClient().Get().AbsPath(metricsAPIPath, "pods").DoRaw(context.TODO())
+	if err != nil {
+		return nil, fmt.Errorf("failed listing pod metrics: %w", err)
+	}
+
+	var list models.PodMetricsList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed decoding pod metrics: %w", err)
+	}
+
+	return util.PodUsages(list), nil
+}
@@ -0,0 +1,113 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+func (c Client) ListMultiKueueClusters() ([]models.MultiKueueCluster, error) {
+	list, err := c.KueueClientset.KueueV1beta2().MultiKueueClusters().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing multikueue clusters: %w", err)
+	}
+
+	var result []models.MultiKueueCluster
+	for _, mkc := range list.Items {
+		result = append(result, toMultiKueueClusterModel(&mkc))
+	}
+	return result, nil
+}
+
+func (c Client) GetMultiKueueCluster(name string) (*models.MultiKueueCluster, error) {
+	mkc, err := c.KueueClientset.KueueV1beta2().MultiKueueClusters().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting multikueue cluster: %w", err)
+	}
+
+	result := toMultiKueueClusterModel(mkc)
+	return &result, nil
+}
+
+// CreateMultiKueueCluster registers a worker cluster, a kubeconfig Secret must exist in the Kueue namespace and hold a "kubeconfig" key
+func (c Client) CreateMultiKueueCluster(mkc models.MultiKueueCluster) (*models.MultiKueueCluster, error) {
+	obj := &kueuev1beta2.MultiKueueCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: mkc.Name,
+		},
+	}
+
+	if kc := mkc.KubeConfig; kc != nil {
+		if kc.LocationType == string(kueuev1beta2.SecretLocationType) {
+			if err := c.checkKubeConfigSecret(kc.Location); err != nil {
+				return nil, err
+			}
+		}
+		obj.Spec.ClusterSource.KubeConfig = &kueuev1beta2.KubeConfig{
+			Location:     kc.Location,
+			LocationType: kueuev1beta2.LocationType(kc.LocationType),
+		}
+	}
+	if mkc.ClusterProfile != "" {
+		obj.Spec.ClusterSource.ClusterProfileRef = &kueuev1beta2.ClusterProfileReference{Name: mkc.ClusterProfile}
+	}
+
+	created, err := c.KueueClientset.KueueV1beta2().MultiKueueClusters().Create(context.TODO(), obj, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating multikueue cluster: %w", err)
+	}
+
+	result := toMultiKueueClusterModel(created)
+	return &result, nil
+}
+
+func (c Client) DeleteMultiKueueCluster(name string) error {
+	err := c.KueueClientset.KueueV1beta2().MultiKueueClusters().Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed deleting multikueue cluster: %w", err)
+	}
+	return nil
+}
+
+// checkKubeConfigSecret checks that a kubeconfig Secret exists in the Kueue namespace and holds a "kubeconfig" key
+func (c Client) checkKubeConfigSecret(name string) error {
+	namespace := c.EnvClient.KueueNamespaceEnv()
+
+	secret, err := c.Clientset.CoreV1().Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return apierrors.NewBadRequest(fmt.Sprintf("kubeconfig secret %s/%s does not exist", namespace, name))
+	}
+	if err != nil {
+		return fmt.Errorf("failed getting kubeconfig secret: %w", err)
+	}
+
+	if _, ok := secret.Data[kueuev1beta2.MultiKueueConfigSecretKey]; !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("kubeconfig secret %s/%s has no '%s' key", namespace, name, kueuev1beta2.MultiKueueConfigSecretKey))
+	}
+	return nil
+}
+
+func toMultiKueueClusterModel(mkc *kueuev1beta2.MultiKueueCluster) models.MultiKueueCluster {
+	m := models.MultiKueueCluster{
+		Active:     meta.IsStatusConditionTrue(mkc.Status.Conditions, kueuev1beta2.MultiKueueClusterActive),
+		Conditions: toConditionModels(mkc.Status.Conditions),
+		Name:       mkc.Name,
+	}
+
+	if kc := mkc.Spec.ClusterSource.KubeConfig; kc != nil {
+		m.KubeConfig = &models.MultiKueueKubeConfig{
+			Location:     kc.Location,
+			LocationType: string(kc.LocationType),
+		}
+	}
+	if ref := mkc.Spec.ClusterSource.ClusterProfileRef; ref != nil {
+		m.ClusterProfile = ref.Name
+	}
+
+	return m
+}
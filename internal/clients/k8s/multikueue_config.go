@@ -0,0 +1,89 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+func (c Client) ListMultiKueueConfigs() ([]models.MultiKueueConfig, error) {
+	list, err := c.KueueClientset.KueueV1beta2().MultiKueueConfigs().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing multikueue configs: %w", err)
+	}
+
+	var result []models.MultiKueueConfig
+	for _, mkc := range list.Items {
+		result = append(result, toMultiKueueConfigModel(&mkc))
+	}
+	return result, nil
+}
+
+func (c Client) GetMultiKueueConfig(name string) (*models.MultiKueueConfig, error) {
+	mkc, err := c.KueueClientset.KueueV1beta2().MultiKueueConfigs().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting multikueue config: %w", err)
+	}
+
+	result := toMultiKueueConfigModel(mkc)
+	return &result, nil
+}
+
+func (c Client) CreateMultiKueueConfig(mkc models.MultiKueueConfig) (*models.MultiKueueConfig, error) {
+	obj := &kueuev1beta2.MultiKueueConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: mkc.Name,
+		},
+		Spec: kueuev1beta2.MultiKueueConfigSpec{
+			Clusters: mkc.Clusters,
+		},
+	}
+
+	created, err := c.KueueClientset.KueueV1beta2().MultiKueueConfigs().Create(context.TODO(), obj, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating multikueue config: %w", err)
+	}
+
+	result := toMultiKueueConfigModel(created)
+	return &result, nil
+}
+
+// UpdateMultiKueueConfig replaces the worker clusters of a multikueue config. A resourceVersion that is no longer current fails with a conflict.
+func (c Client) UpdateMultiKueueConfig(mkc models.MultiKueueConfig) (*models.MultiKueueConfig, error) {
+	existing, err := c.KueueClientset.KueueV1beta2().MultiKueueConfigs().Get(context.TODO(), mkc.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting multikueue config: %w", err)
+	}
+
+	if mkc.ResourceVersion != "" {
+		existing.ResourceVersion = mkc.ResourceVersion
+	}
+	existing.Spec.Clusters = mkc.Clusters
+
+	updated, err := c.KueueClientset.KueueV1beta2().MultiKueueConfigs().Update(context.TODO(), existing, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed updating multikueue config: %w", err)
+	}
+
+	result := toMultiKueueConfigModel(updated)
+	return &result, nil
+}
+
+func (c Client) DeleteMultiKueueConfig(name string) error {
+	err := c.KueueClientset.KueueV1beta2().MultiKueueConfigs().Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed deleting multikueue config: %w", err)
+	}
+	return nil
+}
+
+func toMultiKueueConfigModel(mkc *kueuev1beta2.MultiKueueConfig) models.MultiKueueConfig {
+	return models.MultiKueueConfig{
+		Clusters:        mkc.Spec.Clusters,
+		Name:            mkc.Name,
+		ResourceVersion: mkc.ResourceVersion,
+	}
+}
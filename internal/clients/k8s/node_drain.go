@@ -0,0 +1,171 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	mirrorPodAnnotation    = "kubernetes.io/config.mirror"
+	nodeDrainRetryInterval = 5 * time.Second
+)
+
+// SetNodeUnschedulable cordons or uncordons a node and returns it
+func (c Client) SetNodeUnschedulable(name string, unschedulable bool) (*models.NodeDetail, error) {
+	patch := fmt.Appendf(nil, `{"spec":{"unschedulable":%t}}`, unschedulable)
+	_, err := c.Clientset.CoreV1().Nodes().Patch(context.TODO(), name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed patching node: %w", err)
+	}
+	return c.GetNode(name)
+}
+
+func toNodePodModel(p corev1.Pod) models.NodePod {
+	pod := models.NodePod{
+		Name:      p.Name,
+		Namespace: p.Namespace,
+		Phase:     string(p.Status.Phase),
+	}
+	if owner := metav1.GetControllerOf(&p); owner != nil {
+		pod.DaemonSet = owner.Kind == "DaemonSet"
+		pod.Owner = owner.Kind + "/" + owner.Name
+	}
+	_, pod.Mirror = p.Annotations[mirrorPodAnnotation]
+	pod.EmptyDir = slices.ContainsFunc(p.Spec.Volumes, func(v corev1.Volume) bool {
+		return v.EmptyDir != nil
+	})
+	return pod
+}
+
+// DrainNode cordons a node and evicts its pods through the Eviction API, so PodDisruptionBudgets are respected, until the
+// pods have left the node or the timeout passes. Evictions refused by a budget are retried. Progress is reported after every round.
+func (c Client) DrainNode(name string, opts models.NodeDrainOptions, progress func(models.NodeDrain)) {
+	drain := models.NodeDrain{
+		Node:      name,
+		Options:   opts,
+		StartedAt: time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+		Status:    models.NodeDrainRunning,
+	}
+	finish := func(status, message string) {
+		drain.Status = status
+		drain.Error = message
+		drain.CompletedAt = time.Now().UTC().Format("2006-01-02T15:04:05Z")
+		progress(drain)
+	}
+
+	if _, err := c.SetNodeUnschedulable(name, true); err != nil {
+		finish(models.NodeDrainFailed, err.Error())
+		return
+	}
+
+	deadline := time.Now().Add(time.Duration(opts.TimeoutSeconds) * time.Second)
+	evicted := make(map[string]models.NodeDrainPod)
+	for {
+		pods, err := c.nodePods(name)
+		if err != nil {
+			finish(models.NodeDrainFailed, err.Error())
+			return
+		}
+
+		onNode := make(map[string]bool)
+		drain.Blocked, drain.Remaining, drain.Skipped = nil, nil, nil
+		optIn := 0
+		for _, item := range pods {
+			p := toNodePodModel(item)
+			key := p.Namespace + "/" + p.Name
+			onNode[key] = true
+			pod := models.NodeDrainPod{Name: p.Name, Namespace: p.Namespace}
+
+			if reason := nodeDrainSkipReason(p); reason != "" {
+				pod.Reason = reason
+				drain.Skipped = append(drain.Skipped, pod)
+				continue
+			}
+
+			drain.Remaining = append(drain.Remaining, pod)
+			if _, requested := evicted[key]; requested {
+				// Eviction was accepted, the pod is terminating
+				continue
+			}
+			if p.Owner == "" && !opts.Force {
+				pod.Reason = "not managed by a controller, it would not be recreated, drain with force to evict it"
+				drain.Blocked = append(drain.Blocked, pod)
+				optIn++
+				continue
+			}
+			if p.EmptyDir && !opts.DeleteEmptyDirData {
+				pod.Reason = "has emptyDir volumes whose data would be deleted, drain with deleteEmptyDirData to evict it"
+				drain.Blocked = append(drain.Blocked, pod)
+				optIn++
+				continue
+			}
+
+			err := c.Clientset.CoreV1().Pods(p.Namespace).EvictV1(context.TODO(), &policyv1.Eviction{
+				ObjectMeta:    metav1.ObjectMeta{Name: p.Name, Namespace: p.Namespace},
+				DeleteOptions: &metav1.DeleteOptions{GracePeriodSeconds: opts.GracePeriodSeconds},
+			})
+			switch {
+			case err == nil, apierrors.IsNotFound(err):
+				evicted[key] = pod
+			case apierrors.IsTooManyRequests(err):
+				pod.Reason = "eviction refused by a PodDisruptionBudget: " + err.Error()
+				drain.Blocked = append(drain.Blocked, pod)
+			default:
+				pod.Reason = err.Error()
+				drain.Blocked = append(drain.Blocked, pod)
+			}
+		}
+
+		drain.Evicted = nil
+		for key, pod := range evicted {
+			if !onNode[key] {
+				drain.Evicted = append(drain.Evicted, pod)
+			}
+		}
+		sort.Slice(drain.Evicted, func(i, j int) bool {
+			if drain.Evicted[i].Namespace != drain.Evicted[j].Namespace {
+				return drain.Evicted[i].Namespace < drain.Evicted[j].Namespace
+			}
+			return drain.Evicted[i].Name < drain.Evicted[j].Name
+		})
+
+		switch {
+		case len(drain.Remaining) == 0:
+			finish(models.NodeDrainCompleted, "")
+			return
+		case len(drain.Remaining) == optIn:
+			finish(models.NodeDrainFailed, fmt.Sprintf("%d pods are only evicted with force or deleteEmptyDirData", optIn))
+			return
+		case time.Now().After(deadline):
+			finish(models.NodeDrainTimedOut, fmt.Sprintf("%d pods are still on the node after %ds", len(drain.Remaining), opts.TimeoutSeconds))
+			return
+		}
+
+		progress(drain)
+		time.Sleep(nodeDrainRetryInterval)
+	}
+}
+
+// nodeDrainSkipReason returns why a drain leaves a pod on the node, or an empty string when it is evicted
+func nodeDrainSkipReason(p models.NodePod) string {
+	switch {
+	case p.DaemonSet:
+		return "managed by a DaemonSet"
+	case p.Mirror:
+		return "static pod"
+	case p.Phase == string(corev1.PodSucceeded) || p.Phase == string(corev1.PodFailed):
+		return "finished"
+	default:
+		return ""
+	}
+}
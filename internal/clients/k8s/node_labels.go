@@ -0,0 +1,94 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// UpdateNode changes the labels and taints of a node with a strategic merge patch and returns it. Labels set to nil are removed,
+// mergeTaints is given the current taints of the node and returns its new taints, the taints are left alone when it is nil.
+// The taints of a node are an atomic list without a merge key, so a patch replaces all of them: the patch then carries the
+// resourceVersion the taints were read at and is retried on a conflict, so taints added in between by the node lifecycle
+// controller are kept, and so are the taints that do not change.
+func (c Client) UpdateNode(name string, labels map[string]*string, mergeTaints func([]models.NodeTaint) []models.NodeTaint) (*models.NodeDetail, error) {
+	metadata := map[string]any{}
+	if len(labels) > 0 {
+		metadata["labels"] = labels
+	}
+	patch := map[string]any{"metadata": metadata}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if mergeTaints != nil {
+			node, err := c.Clientset.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			var current []models.NodeTaint
+			for _, t := range node.Spec.Taints {
+				current = append(current, toNodeTaintModel(t))
+			}
+
+			taints := []corev1.Taint{}
+			for _, t := range mergeTaints(current) {
+				taints = append(taints, toNodeTaint(t, node.Spec.Taints))
+			}
+			metadata["resourceVersion"] = node.ResourceVersion
+			patch["spec"] = map[string]any{"taints": taints}
+		}
+		if len(metadata) == 0 {
+			return nil
+		}
+
+		data, err := json.Marshal(patch)
+		if err != nil {
+			return err
+		}
+		_, err = c.Clientset.CoreV1().Nodes().Patch(context.TODO(), name, types.StrategicMergePatchType, data, metav1.PatchOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed updating node: %w", err)
+	}
+	return c.GetNode(name)
+}
+
+// toNodeTaintModel converts a taint of a node to the API model
+func toNodeTaintModel(t corev1.Taint) models.NodeTaint {
+	taint := models.NodeTaint{
+		Effect: string(t.Effect),
+		Key:    t.Key,
+		Value:  t.Value,
+	}
+	if t.TimeAdded != nil {
+		taint.TimeAdded = t.TimeAdded.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	return taint
+}
+
+// toNodeTaint returns the current taint of the node matching the taint as it is, or a new taint. NoExecute taints are given the
+// time they are added, which tolerationSeconds of the pods on the node count from.
+func toNodeTaint(t models.NodeTaint, current []corev1.Taint) corev1.Taint {
+	for _, existing := range current {
+		if existing.Key == t.Key && string(existing.Effect) == t.Effect && existing.Value == t.Value {
+			return existing
+		}
+	}
+
+	taint := corev1.Taint{
+		Effect: corev1.TaintEffect(t.Effect),
+		Key:    t.Key,
+		Value:  t.Value,
+	}
+	if taint.Effect == corev1.TaintEffectNoExecute {
+		taint.TimeAdded = &metav1.Time{Time: metav1.Now().UTC()}
+	}
+	return taint
+}
@@ -0,0 +1,175 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+
+	kaiSchedulingV2alpha2 "github.com/kai-scheduler/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListPodGroups returns the KAI Scheduler PodGroups of a namespace, or of all namespaces when empty, optionally only those of a queue
+func (c Client) ListPodGroups(namespace, queue string) ([]models.PodGroup, error) {
+	list, err := c.KAIPodGroupClient.PodGroups(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing pod groups: %w", err)
+	}
+
+	var result []models.PodGroup
+	namespaces := make(map[string]bool)
+	for _, pg := range list.Items {
+		if queue != "" && pg.Spec.Queue != queue {
+			continue
+		}
+		result = append(result, toPodGroupModel(&pg))
+		namespaces[pg.Namespace] = true
+	}
+
+	// Pods are only listed in the namespaces of the pod groups returned, never across the whole cluster
+	pods := make(map[string][]models.PodGroupPod)
+	for ns := range namespaces {
+		nsPods, err := c.podGroupPods(ns)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(pods, nsPods)
+	}
+	for i, pg := range result {
+		result[i].Bound = countBoundPods(pods[pg.Namespace+"/"+pg.Name])
+	}
+	return result, nil
+}
+
+// GetPodGroup returns a KAI Scheduler PodGroup with its pods
+func (c Client) GetPodGroup(namespace, name string) (*models.PodGroup, error) {
+	pg, err := c.KAIPodGroupClient.PodGroups(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting pod group: %w", err)
+	}
+
+	pods, err := c.podGroupPods(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	result := toPodGroupModel(pg)
+	result.Pods = pods[namespace+"/"+name]
+	result.Bound = countBoundPods(result.Pods)
+	return &result, nil
+}
+
+// podGroupPods returns the pods of a namespace by the namespace and name of their PodGroup
+func (c Client) podGroupPods(namespace string) (map[string][]models.PodGroupPod, error) {
+	list, err := c.Clientset.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing pods: %w", err)
+	}
+
+	result := make(map[string][]models.PodGroupPod)
+	for _, p := range list.Items {
+		podGroup := p.Annotations[kaiPodGroupAnnotation]
+		if podGroup == "" {
+			continue
+		}
+		key := p.Namespace + "/" + podGroup
+		result[key] = append(result[key], toPodGroupPodModel(p))
+	}
+	return result, nil
+}
+
+// countBoundPods counts the pods bound to a node that have not finished
+func countBoundPods(pods []models.PodGroupPod) int32 {
+	var bound int32
+	for _, p := range pods {
+		if p.Node != "" && p.Phase != string(corev1.PodSucceeded) && p.Phase != string(corev1.PodFailed) {
+			bound++
+		}
+	}
+	return bound
+}
+
+func toPodGroupPodModel(p corev1.Pod) models.PodGroupPod {
+	return models.PodGroupPod{
+		Name:  p.Name,
+		Node:  p.Spec.NodeName,
+		Phase: string(p.Status.Phase),
+	}
+}
+
+func toPodGroupModel(pg *kaiSchedulingV2alpha2.PodGroup) models.PodGroup {
+	var conditions []models.Condition
+	for _, c := range pg.Status.Conditions {
+		conditions = append(conditions, models.Condition{
+			LastTransitionTime: c.LastTransitionTime.Format("2006-01-02T15:04:05Z"),
+			Message:            c.Message,
+			Reason:             c.Reason,
+			Status:             string(c.Status),
+			Type:               string(c.Type),
+		})
+	}
+
+	return models.PodGroup{
+		Conditions:           conditions,
+		CreationTimestamp:    pg.CreationTimestamp.Format("2006-01-02T15:04:05Z"),
+		Failed:               pg.Status.Failed,
+		LastSchedulerMessage: lastSchedulerMessage(pg.Status.SchedulingConditions),
+		MinMember:            pg.Spec.MinMember,
+		Name:                 pg.Name,
+		Namespace:            pg.Namespace,
+		Pending:              pg.Status.Pending,
+		Phase:                string(pg.Status.Phase),
+		Queue:                pg.Spec.Queue,
+		Running:              pg.Status.Running,
+		SchedulingConditions: toPodGroupSchedulingConditionModels(pg.Status.SchedulingConditions),
+		Succeeded:            pg.Status.Succeeded,
+	}
+}
+
+func toPodGroupSchedulingConditionModels(conditions []kaiSchedulingV2alpha2.SchedulingCondition) []models.PodGroupSchedulingCondition {
+	var result []models.PodGroupSchedulingCondition
+	for _, c := range conditions {
+		condition := models.PodGroupSchedulingCondition{
+			LastTransitionTime: c.LastTransitionTime.Format("2006-01-02T15:04:05Z"),
+			Message:            c.Message,
+			NodePool:           c.NodePool,
+			Status:             string(c.Status),
+			Type:               string(c.Type),
+		}
+		for _, r := range c.Reasons {
+			condition.Reasons = append(condition.Reasons, models.PodGroupUnschedulableReason{
+				Message: r.Message,
+				Reason:  string(r.Reason),
+			})
+		}
+		result = append(result, condition)
+	}
+	return result
+}
+
+// lastSchedulerMessage returns the message of the most recent scheduling condition, the scheduler records one for
+// every attempt that could not schedule the whole group. Newer schedulers only fill in the reasons.
+func lastSchedulerMessage(conditions []kaiSchedulingV2alpha2.SchedulingCondition) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+
+	sorted := append([]kaiSchedulingV2alpha2.SchedulingCondition(nil), conditions...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].LastTransitionTime.Before(&sorted[j].LastTransitionTime)
+	})
+
+	last := sorted[len(sorted)-1]
+	if last.Message != "" {
+		return last.Message
+	}
+	for _, r := range last.Reasons {
+		if r.Message != "" {
+			return r.Message
+		}
+	}
+	return ""
+}
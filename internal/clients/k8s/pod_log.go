@@ -0,0 +1,28 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// StreamPodLogs opens a stream of the logs of a pod container, the caller must close it
+func (c Client) StreamPodLogs(namespace, name string, opts models.PodLogOptions) (io.ReadCloser, error) {
+	req := c.Clientset.CoreV1().Pods(namespace).GetLogs(name, &corev1.PodLogOptions{
+		Container:    opts.Container,
+		Follow:       opts.Follow,
+		Previous:     opts.Previous,
+		SinceSeconds: opts.SinceSeconds,
+		TailLines:    opts.TailLines,
+		Timestamps:   opts.Timestamps,
+	})
+
+	stream, err := req.Stream(context.TODO())
+	if err != nil {
+		return nil, fmt.Errorf("failed streaming pod logs: %w", err)
+	}
+	return stream, nil
+}
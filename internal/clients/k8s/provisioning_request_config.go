@@ -0,0 +1,117 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+func (c Client) ListProvisioningRequestConfigs() ([]models.ProvisioningRequestConfig, error) {
+	list, err := c.KueueClientset.KueueV1beta2().ProvisioningRequestConfigs().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing provisioning request configs: %w", err)
+	}
+
+	var result []models.ProvisioningRequestConfig
+	for _, prc := range list.Items {
+		result = append(result, toProvisioningRequestConfigModel(&prc))
+	}
+	return result, nil
+}
+
+func (c Client) GetProvisioningRequestConfig(name string) (*models.ProvisioningRequestConfig, error) {
+	prc, err := c.KueueClientset.KueueV1beta2().ProvisioningRequestConfigs().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting provisioning request config: %w", err)
+	}
+
+	result := toProvisioningRequestConfigModel(prc)
+	return &result, nil
+}
+
+func (c Client) CreateProvisioningRequestConfig(prc models.ProvisioningRequestConfig) (*models.ProvisioningRequestConfig, error) {
+	obj := &kueuev1beta2.ProvisioningRequestConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: prc.Name,
+		},
+		Spec: kueuev1beta2.ProvisioningRequestConfigSpec{
+			ProvisioningClassName: prc.ProvisioningClassName,
+		},
+	}
+
+	if len(prc.Parameters) > 0 {
+		obj.Spec.Parameters = make(map[string]kueuev1beta2.Parameter, len(prc.Parameters))
+		for k, v := range prc.Parameters {
+			obj.Spec.Parameters[k] = kueuev1beta2.Parameter(v)
+		}
+	}
+
+	for _, r := range prc.ManagedResources {
+		obj.Spec.ManagedResources = append(obj.Spec.ManagedResources, corev1.ResourceName(r))
+	}
+
+	if prc.PodSetMergePolicy != "" {
+		policy := kueuev1beta2.ProvisioningRequestConfigPodSetMergePolicy(prc.PodSetMergePolicy)
+		obj.Spec.PodSetMergePolicy = &policy
+	}
+
+	if rs := prc.RetryStrategy; rs != nil {
+		obj.Spec.RetryStrategy = &kueuev1beta2.ProvisioningRequestRetryStrategy{
+			BackoffBaseSeconds: rs.BackoffBaseSeconds,
+			BackoffLimitCount:  rs.BackoffLimitCount,
+			BackoffMaxSeconds:  rs.BackoffMaxSeconds,
+		}
+	}
+
+	created, err := c.KueueClientset.KueueV1beta2().ProvisioningRequestConfigs().Create(context.TODO(), obj, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating provisioning request config: %w", err)
+	}
+
+	result := toProvisioningRequestConfigModel(created)
+	return &result, nil
+}
+
+func (c Client) DeleteProvisioningRequestConfig(name string) error {
+	err := c.KueueClientset.KueueV1beta2().ProvisioningRequestConfigs().Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed deleting provisioning request config: %w", err)
+	}
+	return nil
+}
+
+func toProvisioningRequestConfigModel(prc *kueuev1beta2.ProvisioningRequestConfig) models.ProvisioningRequestConfig {
+	m := models.ProvisioningRequestConfig{
+		Name:                  prc.Name,
+		ProvisioningClassName: prc.Spec.ProvisioningClassName,
+	}
+
+	if len(prc.Spec.Parameters) > 0 {
+		m.Parameters = make(map[string]string, len(prc.Spec.Parameters))
+		for k, v := range prc.Spec.Parameters {
+			m.Parameters[k] = string(v)
+		}
+	}
+
+	for _, r := range prc.Spec.ManagedResources {
+		m.ManagedResources = append(m.ManagedResources, string(r))
+	}
+
+	if prc.Spec.PodSetMergePolicy != nil {
+		m.PodSetMergePolicy = string(*prc.Spec.PodSetMergePolicy)
+	}
+
+	if rs := prc.Spec.RetryStrategy; rs != nil {
+		m.RetryStrategy = &models.ProvisioningRequestRetryStrategy{
+			BackoffBaseSeconds: rs.BackoffBaseSeconds,
+			BackoffLimitCount:  rs.BackoffLimitCount,
+			BackoffMaxSeconds:  rs.BackoffMaxSeconds,
+		}
+	}
+
+	return m
+}
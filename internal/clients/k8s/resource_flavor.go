@@ -38,19 +38,60 @@ func (c Client) CreateResourceFlavor(rf models.ResourceFlavor) (*models.Resource
 		ObjectMeta: metav1.ObjectMeta{
 			Name: rf.Name,
 		},
-		Spec: kueuev1beta2.ResourceFlavorSpec{
-			NodeLabels: rf.NodeLabels,
-		},
 	}
+	setResourceFlavorSpec(&obj.Spec, rf)
+
+	created, err := c.KueueClientset.KueueV1beta2().ResourceFlavors().Create(context.TODO(), obj, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating resource flavor: %w", err)
+	}
+
+	result := toResourceFlavorModel(created)
+	return &result, nil
+}
+
+// UpdateResourceFlavor replaces the spec of a resource flavor. A resourceVersion that is no longer current fails with a conflict.
+func (c Client) UpdateResourceFlavor(rf models.ResourceFlavor) (*models.ResourceFlavor, error) {
+	obj, err := c.KueueClientset.KueueV1beta2().ResourceFlavors().Get(context.TODO(), rf.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting resource flavor: %w", err)
+	}
+
+	if rf.ResourceVersion != "" {
+		obj.ResourceVersion = rf.ResourceVersion
+	}
+	setResourceFlavorSpec(&obj.Spec, rf)
+
+	updated, err := c.KueueClientset.KueueV1beta2().ResourceFlavors().Update(context.TODO(), obj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed updating resource flavor: %w", err)
+	}
+
+	result := toResourceFlavorModel(updated)
+	return &result, nil
+}
 
+func (c Client) DeleteResourceFlavor(name string) error {
+	err := c.KueueClientset.KueueV1beta2().ResourceFlavors().Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed deleting resource flavor: %w", err)
+	}
+	return nil
+}
+
+func setResourceFlavorSpec(spec *kueuev1beta2.ResourceFlavorSpec, rf models.ResourceFlavor) {
+	spec.NodeLabels = rf.NodeLabels
+
+	spec.NodeTaints = nil
 	for _, t := range rf.NodeTaints {
-		obj.Spec.NodeTaints = append(obj.Spec.NodeTaints, corev1.Taint{
+		spec.NodeTaints = append(spec.NodeTaints, corev1.Taint{
 			Key:    t.Key,
 			Value:  t.Value,
 			Effect: corev1.TaintEffect(t.Effect),
 		})
 	}
 
+	spec.Tolerations = nil
 	for _, t := range rf.Tolerations {
 		tol := corev1.Toleration{
 			Effect:   corev1.TaintEffect(t.Effect),
@@ -61,35 +102,21 @@ func (c Client) CreateResourceFlavor(rf models.ResourceFlavor) (*models.Resource
 		if t.TolerationSeconds != nil {
 			tol.TolerationSeconds = t.TolerationSeconds
 		}
-		obj.Spec.Tolerations = append(obj.Spec.Tolerations, tol)
+		spec.Tolerations = append(spec.Tolerations, tol)
 	}
 
+	spec.TopologyName = nil
 	if rf.TopologyName != "" {
 		ref := kueuev1beta2.TopologyReference(rf.TopologyName)
-		obj.Spec.TopologyName = &ref
-	}
-
-	created, err := c.KueueClientset.KueueV1beta2().ResourceFlavors().Create(context.TODO(), obj, metav1.CreateOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed creating resource flavor: %w", err)
+		spec.TopologyName = &ref
 	}
-
-	result := toResourceFlavorModel(created)
-	return &result, nil
-}
-
-func (c Client) DeleteResourceFlavor(name string) error {
-	err := c.KueueClientset.KueueV1beta2().ResourceFlavors().Delete(context.TODO(), name, metav1.DeleteOptions{})
-	if err != nil {
-		return fmt.Errorf("failed deleting resource flavor: %w", err)
-	}
-	return nil
 }
 
 func toResourceFlavorModel(rf *kueuev1beta2.ResourceFlavor) models.ResourceFlavor {
 	m := models.ResourceFlavor{
-		Name:       rf.Name,
-		NodeLabels: rf.Spec.NodeLabels,
+		Name:            rf.Name,
+		NodeLabels:      rf.Spec.NodeLabels,
+		ResourceVersion: rf.ResourceVersion,
 	}
 
 	for _, t := range rf.Spec.NodeTaints {
@@ -0,0 +1,68 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+func (c Client) ListTopologies() ([]models.Topology, error) {
+	list, err := c.KueueClientset.KueueV1beta2().Topologies().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing topologies: %w", err)
+	}
+
+	var result []models.Topology
+	for _, t := range list.Items {
+		result = append(result, toTopologyModel(&t))
+	}
+	return result, nil
+}
+
+func (c Client) GetTopology(name string) (*models.Topology, error) {
+	t, err := c.KueueClientset.KueueV1beta2().Topologies().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting topology: %w", err)
+	}
+
+	result := toTopologyModel(t)
+	return &result, nil
+}
+
+func (c Client) CreateTopology(t models.Topology) (*models.Topology, error) {
+	obj := &kueuev1beta2.Topology{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: t.Name,
+		},
+	}
+	for _, level := range t.Levels {
+		obj.Spec.Levels = append(obj.Spec.Levels, kueuev1beta2.TopologyLevel{NodeLabel: level})
+	}
+
+	created, err := c.KueueClientset.KueueV1beta2().Topologies().Create(context.TODO(), obj, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating topology: %w", err)
+	}
+
+	result := toTopologyModel(created)
+	return &result, nil
+}
+
+func (c Client) DeleteTopology(name string) error {
+	err := c.KueueClientset.KueueV1beta2().Topologies().Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed deleting topology: %w", err)
+	}
+	return nil
+}
+
+func toTopologyModel(t *kueuev1beta2.Topology) models.Topology {
+	m := models.Topology{Name: t.Name}
+	for _, level := range t.Spec.Levels {
+		m.Levels = append(m.Levels, level.NodeLabel)
+	}
+	return m
+}
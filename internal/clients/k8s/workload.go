@@ -0,0 +1,185 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"cmyk/internal/util"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// ListWorkloads returns workloads, optionally filtered by namespace, LocalQueue, ClusterQueue, state and
+// MultiKueue worker cluster. Workloads that are not admitted yet match the ClusterQueue of their LocalQueue.
+func (c Client) ListWorkloads(namespace, localQueue, clusterQueue, state, cluster string) ([]models.Workload, error) {
+	list, err := c.KueueClientset.KueueV1beta2().Workloads(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing workloads: %w", err)
+	}
+
+	queueToCQ := make(map[string]string)
+	if clusterQueue != "" {
+		lqs, err := c.KueueClientset.KueueV1beta2().LocalQueues(namespace).List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed listing local queues: %w", err)
+		}
+		for _, lq := range lqs.Items {
+			queueToCQ[lq.Namespace+"/"+lq.Name] = string(lq.Spec.ClusterQueue)
+		}
+	}
+
+	var result []models.Workload
+	for _, wl := range list.Items {
+		m := toWorkloadModel(&wl)
+		if localQueue != "" && m.Queue != localQueue {
+			continue
+		}
+		if clusterQueue != "" {
+			cq := m.ClusterQueue
+			if cq == "" {
+				cq = queueToCQ[m.Namespace+"/"+m.Queue]
+			}
+			if cq != clusterQueue {
+				continue
+			}
+		}
+		if state != "" && !strings.EqualFold(m.State, state) {
+			continue
+		}
+		if cluster != "" && m.ClusterName != cluster {
+			continue
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (c Client) GetWorkload(namespace, name string) (*models.Workload, error) {
+	wl, err := c.KueueClientset.KueueV1beta2().Workloads(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting workload: %w", err)
+	}
+
+	result := toWorkloadModel(wl)
+	return &result, nil
+}
+
+func toWorkloadModel(wl *kueuev1beta2.Workload) models.Workload {
+	m := models.Workload{
+		Active:            wl.Spec.Active == nil || *wl.Spec.Active,
+		Conditions:        toConditionModels(wl.Status.Conditions),
+		CreationTimestamp: wl.CreationTimestamp.Format("2006-01-02T15:04:05Z"),
+		Name:              wl.Name,
+		Namespace:         wl.Namespace,
+		Priority:          wl.Spec.Priority,
+		Queue:             string(wl.Spec.QueueName),
+		State:             toWorkloadState(wl.Status.Conditions),
+	}
+
+	// Workloads dispatched by MultiKueue record the worker cluster they were admitted on
+	if wl.Status.ClusterName != nil {
+		m.ClusterName = *wl.Status.ClusterName
+	}
+	m.NominatedClusters = wl.Status.NominatedClusterNames
+
+	if wl.Spec.PriorityClassRef != nil {
+		m.PriorityClass = wl.Spec.PriorityClassRef.Name
+	}
+
+	// Conditions are returned oldest first so they read as the admission history
+	sort.SliceStable(m.Conditions, func(i, j int) bool {
+		return m.Conditions[i].LastTransitionTime < m.Conditions[j].LastTransitionTime
+	})
+
+	for _, or := range wl.OwnerReferences {
+		m.Owners = append(m.Owners, models.OwnerReference{
+			APIVersion: or.APIVersion,
+			Kind:       or.Kind,
+			Name:       or.Name,
+			UID:        string(or.UID),
+		})
+	}
+
+	assignments := make(map[kueuev1beta2.PodSetReference]kueuev1beta2.PodSetAssignment)
+	if wl.Status.Admission != nil {
+		m.ClusterQueue = string(wl.Status.Admission.ClusterQueue)
+		for _, a := range wl.Status.Admission.PodSetAssignments {
+			assignments[a.Name] = a
+		}
+	}
+
+	for _, ps := range wl.Spec.PodSets {
+		podSet := models.WorkloadPodSet{
+			Count:    ps.Count,
+			MinCount: ps.MinCount,
+			Name:     string(ps.Name),
+			Requests: toResourceListModel(podRequests(ps.Template.Spec)),
+		}
+		if a, assigned := assignments[ps.Name]; assigned {
+			if len(a.Flavors) > 0 {
+				podSet.Flavors = make(map[string]string, len(a.Flavors))
+				for r, f := range a.Flavors {
+					podSet.Flavors[string(r)] = string(f)
+				}
+			}
+			podSet.ResourceUsage = toResourceListModel(a.ResourceUsage)
+		}
+		m.PodSets = append(m.PodSets, podSet)
+	}
+
+	for _, ac := range wl.Status.AdmissionChecks {
+		m.AdmissionChecks = append(m.AdmissionChecks, models.WorkloadAdmissionCheck{
+			LastTransitionTime: ac.LastTransitionTime.Format("2006-01-02T15:04:05Z"),
+			Message:            ac.Message,
+			Name:               string(ac.Name),
+			RetryCount:         ac.RetryCount,
+			State:              string(ac.State),
+		})
+	}
+
+	if rs := wl.Status.RequeueState; rs != nil {
+		m.RequeueState = &models.WorkloadRequeueState{Count: rs.Count}
+		if rs.RequeueAt != nil {
+			m.RequeueState.RequeueAt = rs.RequeueAt.Format("2006-01-02T15:04:05Z")
+		}
+	}
+
+	return m
+}
+
+// podRequests returns the resources requested by a single pod, init containers run one at a time so only the largest counts
+func podRequests(spec corev1.PodSpec) corev1.ResourceList {
+	return podResources(spec, func(r corev1.ResourceRequirements) corev1.ResourceList { return r.Requests })
+}
+
+// podLimits returns the resource limits of a single pod, counted the same way as its requests
+func podLimits(spec corev1.PodSpec) corev1.ResourceList {
+	return podResources(spec, func(r corev1.ResourceRequirements) corev1.ResourceList { return r.Limits })
+}
+
+func podResources(spec corev1.PodSpec, list func(corev1.ResourceRequirements) corev1.ResourceList) corev1.ResourceList {
+	var containers, initContainers []corev1.ResourceList
+	for _, c := range spec.Containers {
+		containers = append(containers, list(c.Resources))
+	}
+	for _, c := range spec.InitContainers {
+		initContainers = append(initContainers, list(c.Resources))
+	}
+	return util.PodResources(containers, initContainers, spec.Overhead)
+}
+
+func toResourceListModel(list corev1.ResourceList) map[string]string {
+	if len(list) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(list))
+	for name, q := range list {
+		result[string(name)] = q.String()
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+package k8s
+
+import (
+	"cmyk/internal/models"
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+func (c Client) ListWorkloadPriorityClasses() ([]models.WorkloadPriorityClass, error) {
+	list, err := c.KueueClientset.KueueV1beta2().WorkloadPriorityClasses().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing workload priority classes: %w", err)
+	}
+
+	var result []models.WorkloadPriorityClass
+	for _, pc := range list.Items {
+		result = append(result, toWorkloadPriorityClassModel(&pc))
+	}
+	return result, nil
+}
+
+func (c Client) GetWorkloadPriorityClass(name string) (*models.WorkloadPriorityClass, error) {
+	pc, err := c.KueueClientset.KueueV1beta2().WorkloadPriorityClasses().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting workload priority class: %w", err)
+	}
+
+	result := toWorkloadPriorityClassModel(pc)
+	return &result, nil
+}
+
+func (c Client) CreateWorkloadPriorityClass(pc models.WorkloadPriorityClass) (*models.WorkloadPriorityClass, error) {
+	obj := &kueuev1beta2.WorkloadPriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: pc.Name,
+		},
+		Value:       pc.Value,
+		Description: pc.Description,
+	}
+
+	created, err := c.KueueClientset.KueueV1beta2().WorkloadPriorityClasses().Create(context.TODO(), obj, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating workload priority class: %w", err)
+	}
+
+	result := toWorkloadPriorityClassModel(created)
+	return &result, nil
+}
+
+// UpdateWorkloadPriorityClass replaces the value and description, workloads that already exist keep the priority they were created with.
+// A resourceVersion that is no longer current fails with a conflict.
+func (c Client) UpdateWorkloadPriorityClass(pc models.WorkloadPriorityClass) (*models.WorkloadPriorityClass, error) {
+	obj, err := c.KueueClientset.KueueV1beta2().WorkloadPriorityClasses().Get(context.TODO(), pc.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting workload priority class: %w", err)
+	}
+
+	if pc.ResourceVersion != "" {
+		obj.ResourceVersion = pc.ResourceVersion
+	}
+
+	obj.Value = pc.Value
+	obj.Description = pc.Description
+
+	updated, err := c.KueueClientset.KueueV1beta2().WorkloadPriorityClasses().Update(context.TODO(), obj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed updating workload priority class: %w", err)
+	}
+
+	result := toWorkloadPriorityClassModel(updated)
+	return &result, nil
+}
+
+func (c Client) DeleteWorkloadPriorityClass(name string) error {
+	err := c.KueueClientset.KueueV1beta2().WorkloadPriorityClasses().Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed deleting workload priority class: %w", err)
+	}
+	return nil
+}
+
+func toWorkloadPriorityClassModel(pc *kueuev1beta2.WorkloadPriorityClass) models.WorkloadPriorityClass {
+	return models.WorkloadPriorityClass{
+		Description:     pc.Description,
+		Name:            pc.Name,
+		ResourceVersion: pc.ResourceVersion,
+		Value:           pc.Value,
+	}
+}
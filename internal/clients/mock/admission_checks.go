@@ -0,0 +1,57 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+
+	"encoding/json"
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListAdmissionChecks reads and parses the mock admission checks data from JSON file
+func (c Client) ListAdmissionChecks() ([]models.AdmissionCheck, error) {
+	data, err := os.ReadFile("./internal/clients/mock/admission_checks.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.AdmissionCheck
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAdmissionCheck reads and returns a mock admission check by name
+func (c Client) GetAdmissionCheck(name string) (*models.AdmissionCheck, error) {
+	checks, err := c.ListAdmissionChecks()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ac := range checks {
+		if ac.Name == name {
+			return &ac, nil
+		}
+	}
+
+	return nil, fiber.ErrNotFound
+}
+
+// CreateAdmissionCheck checks that a mock admission check does not already exist and returns it
+func (c Client) CreateAdmissionCheck(ac models.AdmissionCheck) (*models.AdmissionCheck, error) {
+	if _, err := c.GetAdmissionCheck(ac.Name); err == nil {
+		return nil, fiber.NewError(fiber.StatusConflict, "admission check "+ac.Name+" already exists")
+	}
+	return &ac, nil
+}
+
+// DeleteAdmissionCheck checks that a mock admission check exists
+func (c Client) DeleteAdmissionCheck(name string) error {
+	if _, err := c.GetAdmissionCheck(name); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+
+	"encoding/json"
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func loadBindRequests() ([]models.BindRequest, error) {
+	data, err := os.ReadFile("./internal/clients/mock/bind_requests.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var bindRequests []models.BindRequest
+	if err := json.Unmarshal(data, &bindRequests); err != nil {
+		return nil, err
+	}
+	return bindRequests, nil
+}
+
+// ListBindRequests reads and parses the mock bind requests data from JSON file, optionally filtered by namespace, pod, node and phase
+func (c Client) ListBindRequests(namespace, pod, node, phase string) ([]models.BindRequest, error) {
+	bindRequests, err := loadBindRequests()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.BindRequest
+	for _, br := range bindRequests {
+		if namespace != "" && br.Namespace != namespace {
+			continue
+		}
+		if pod != "" && br.Pod != pod {
+			continue
+		}
+		if node != "" && br.Node != node {
+			continue
+		}
+		if phase != "" && br.Phase != phase {
+			continue
+		}
+		result = append(result, br)
+	}
+
+	return result, nil
+}
+
+// GetBindRequest reads and returns a single mock bind request
+func (c Client) GetBindRequest(namespace, name string) (*models.BindRequest, error) {
+	bindRequests, err := loadBindRequests()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, br := range bindRequests {
+		if br.Namespace == namespace && br.Name == name {
+			return &br, nil
+		}
+	}
+
+	return nil, fiber.ErrNotFound
+}
@@ -0,0 +1,88 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+
+	"encoding/json"
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListClusterQueues reads and parses the mock cluster queues data from JSON file
+func (c Client) ListClusterQueues() ([]models.ClusterQueue, error) {
+	data, err := os.ReadFile("./internal/clients/mock/cluster_queues.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.ClusterQueue
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetClusterQueue reads and returns a mock cluster queue by name
+func (c Client) GetClusterQueue(name string) (*models.ClusterQueue, error) {
+	queues, err := c.ListClusterQueues()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cq := range queues {
+		if cq.Name == name {
+			return &cq, nil
+		}
+	}
+
+	return nil, fiber.ErrNotFound
+}
+
+// CreateClusterQueue checks that a mock cluster queue does not already exist and returns it
+func (c Client) CreateClusterQueue(cq models.ClusterQueue) (*models.ClusterQueue, error) {
+	if _, err := c.GetClusterQueue(cq.Name); err == nil {
+		return nil, fiber.NewError(fiber.StatusConflict, "cluster queue "+cq.Name+" already exists")
+	}
+	return &cq, nil
+}
+
+// UpdateClusterQueue checks that a mock cluster queue exists and that the update is based on its current resourceVersion,
+// the update is returned with its status and a new resourceVersion
+func (c Client) UpdateClusterQueue(cq models.ClusterQueue) (*models.ClusterQueue, error) {
+	existing, err := c.GetClusterQueue(cq.Name)
+	if err != nil {
+		return nil, err
+	}
+	if cq.ResourceVersion, err = nextResourceVersion(existing.ResourceVersion, cq.ResourceVersion); err != nil {
+		return nil, err
+	}
+
+	cq.AdmittedWorkloads = existing.AdmittedWorkloads
+	cq.Conditions = existing.Conditions
+	cq.FlavorsReservation = existing.FlavorsReservation
+	cq.FlavorsUsage = existing.FlavorsUsage
+	cq.PendingWorkloads = existing.PendingWorkloads
+	cq.ReservingWorkloads = existing.ReservingWorkloads
+	return &cq, nil
+}
+
+// DeleteClusterQueue checks that a mock cluster queue exists
+func (c Client) DeleteClusterQueue(name string) error {
+	if _, err := c.GetClusterQueue(name); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetClusterQueueAdmissionChecks checks that a mock cluster queue exists and returns it with the new admission checks
+func (c Client) SetClusterQueueAdmissionChecks(name string, checks []models.ClusterQueueAdmissionCheck) (*models.ClusterQueue, error) {
+	cq, err := c.GetClusterQueue(name)
+	if err != nil {
+		return nil, err
+	}
+
+	cq.AdmissionChecks = checks
+	return cq, nil
+}
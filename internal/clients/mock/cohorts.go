@@ -0,0 +1,70 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+
+	"encoding/json"
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListCohorts reads and parses the mock cohorts data from JSON file
+func (c Client) ListCohorts() ([]models.Cohort, error) {
+	data, err := os.ReadFile("./internal/clients/mock/cohorts.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.Cohort
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetCohort reads and returns a mock cohort by name
+func (c Client) GetCohort(name string) (*models.Cohort, error) {
+	cohorts, err := c.ListCohorts()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, co := range cohorts {
+		if co.Name == name {
+			return &co, nil
+		}
+	}
+
+	return nil, fiber.ErrNotFound
+}
+
+// CreateCohort checks that a mock cohort does not already exist and returns it
+func (c Client) CreateCohort(co models.Cohort) (*models.Cohort, error) {
+	if _, err := c.GetCohort(co.Name); err == nil {
+		return nil, fiber.NewError(fiber.StatusConflict, "cohort "+co.Name+" already exists")
+	}
+	return &co, nil
+}
+
+// UpdateCohort checks that a mock cohort exists and that the update is based on its current resourceVersion,
+// the update is returned with a new resourceVersion
+func (c Client) UpdateCohort(co models.Cohort) (*models.Cohort, error) {
+	existing, err := c.GetCohort(co.Name)
+	if err != nil {
+		return nil, err
+	}
+	if co.ResourceVersion, err = nextResourceVersion(existing.ResourceVersion, co.ResourceVersion); err != nil {
+		return nil, err
+	}
+	return &co, nil
+}
+
+// DeleteCohort checks that a mock cohort exists
+func (c Client) DeleteCohort(name string) error {
+	if _, err := c.GetCohort(name); err != nil {
+		return err
+	}
+	return nil
+}
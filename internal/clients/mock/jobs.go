@@ -0,0 +1,116 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func loadJobs() ([]models.JobDetail, error) {
+	data, err := os.ReadFile("./internal/clients/mock/jobs.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []models.JobDetail
+	if err := json.Unmarshal(data, &jobs); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// ListJobs reads and returns mock jobs, optionally filtered by namespace, queue and state
+func (c Client) ListJobs(namespace, queue, state string) ([]models.JobDetail, error) {
+	jobs, err := loadJobs()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.JobDetail
+	for _, j := range jobs {
+		if namespace != "" && j.Spec.Namespace != namespace {
+			continue
+		}
+		if queue != "" && j.Spec.Queue != queue {
+			continue
+		}
+		if state != "" && !strings.EqualFold(j.Status.State, state) {
+			continue
+		}
+		// Pods are only returned by the detail view
+		j.Pods = nil
+		result = append(result, j)
+	}
+
+	return result, nil
+}
+
+// GetJob reads and returns a mock job by namespace and name
+func (c Client) GetJob(namespace, name string) (*models.JobDetail, error) {
+	jobs, err := loadJobs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, j := range jobs {
+		if j.Spec.Namespace == namespace && j.Spec.Name == name {
+			return &j, nil
+		}
+	}
+
+	return nil, fiber.ErrNotFound
+}
+
+// DeleteJob checks that a mock job exists
+func (c Client) DeleteJob(namespace, name, _ string) error {
+	if _, err := c.GetJob(namespace, name); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SuspendJob returns the admission of a mock job with its Workload deactivated
+func (c Client) SuspendJob(namespace, name string) (*models.JobAdmission, error) {
+	return c.setJobActive(namespace, name, false)
+}
+
+// ResumeJob returns the admission of a mock job with its Workload reactivated
+func (c Client) ResumeJob(namespace, name string) (*models.JobAdmission, error) {
+	return c.setJobActive(namespace, name, true)
+}
+
+// RequeueJob returns the admission of a requeued mock job, pending until Kueue admits its new Workload
+func (c Client) RequeueJob(namespace, name string) (*models.JobAdmission, error) {
+	job, err := c.GetJob(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if job.Admission == nil {
+		return nil, fiber.NewError(fiber.StatusConflict, "kueue has not created a workload for the job yet")
+	}
+
+	return &models.JobAdmission{Active: true, State: "Pending"}, nil
+}
+
+func (c Client) setJobActive(namespace, name string, active bool) (*models.JobAdmission, error) {
+	job, err := c.GetJob(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if job.Admission == nil {
+		state := "Suspended"
+		if active {
+			state = "Pending"
+		}
+		return &models.JobAdmission{Active: active, State: state}, nil
+	}
+
+	admission := job.Admission
+	admission.Active = active
+	return admission, nil
+}
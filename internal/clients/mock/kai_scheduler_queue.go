@@ -16,10 +16,13 @@ type rawKaiSchedulerResource struct {
 
 type rawKaiSchedulerQueue struct {
 	Metadata struct {
-		Name string `json:"name"`
+		Name            string `json:"name"`
+		ResourceVersion string `json:"resourceVersion"`
 	} `json:"metadata"`
 	Spec struct {
+		DisplayName string `json:"displayName"`
 		ParentQueue string `json:"parentQueue"`
+		Priority    *int   `json:"priority"`
 		Resources   struct {
 			Cpu    rawKaiSchedulerResource  `json:"cpu"`
 			Gpu    rawKaiSchedulerResource  `json:"gpu"`
@@ -27,7 +30,10 @@ type rawKaiSchedulerQueue struct {
 		} `json:"resources"`
 	} `json:"spec"`
 	Status struct {
-		ChildQueues []string `json:"childQueues"`
+		Allocated               map[string]string `json:"allocated"`
+		AllocatedNonPreemptible map[string]string `json:"allocatedNonPreemptible"`
+		ChildQueues             []string          `json:"childQueues"`
+		Requested               map[string]string `json:"requested"`
 	} `json:"status"`
 }
 
@@ -55,6 +61,14 @@ func toQueueResources(r rawKaiSchedulerQueue) models.KaiSchedulerQueueResources
 	return res
 }
 
+func toQueueStatus(r rawKaiSchedulerQueue) *models.KaiSchedulerQueueStatus {
+	return &models.KaiSchedulerQueueStatus{
+		Allocated:               r.Status.Allocated,
+		AllocatedNonPreemptible: r.Status.AllocatedNonPreemptible,
+		Requested:               r.Status.Requested,
+	}
+}
+
 func loadRawKaiSchedulerQueues() ([]rawKaiSchedulerQueue, error) {
 	data, err := os.ReadFile("./internal/clients/mock/kai_scheduler_queues.json")
 	if err != nil {
@@ -85,6 +99,7 @@ func (c Client) ListKaiSchedulerParentQueues() ([]models.KaiSchedulerParentQueue
 			ChildQueues: item.Status.ChildQueues,
 			Name:        item.Metadata.Name,
 			Resources:   toQueueResources(item),
+			Status:      toQueueStatus(item),
 		})
 	}
 
@@ -119,8 +134,84 @@ func (c Client) GetKaiSchedulerChildQueues(parent string) ([]models.KaiScheduler
 			Name:      item.Metadata.Name,
 			Parent:    item.Spec.ParentQueue,
 			Resources: toQueueResources(item),
+			Status:    toQueueStatus(item),
 		})
 	}
 
 	return result, nil
 }
+
+func toKaiSchedulerQueue(item rawKaiSchedulerQueue) models.KaiSchedulerQueue {
+	return models.KaiSchedulerQueue{
+		ChildQueues:     item.Status.ChildQueues,
+		DisplayName:     item.Spec.DisplayName,
+		Name:            item.Metadata.Name,
+		Parent:          item.Spec.ParentQueue,
+		Priority:        item.Spec.Priority,
+		ResourceVersion: item.Metadata.ResourceVersion,
+		Resources:       toQueueResources(item),
+		Status:          toQueueStatus(item),
+	}
+}
+
+// ListKaiSchedulerQueues reads and returns all mock queues of the hierarchy
+func (c Client) ListKaiSchedulerQueues() ([]models.KaiSchedulerQueue, error) {
+	items, err := loadRawKaiSchedulerQueues()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.KaiSchedulerQueue
+	for _, item := range items {
+		result = append(result, toKaiSchedulerQueue(item))
+	}
+
+	return result, nil
+}
+
+// GetKaiSchedulerQueue reads and returns a mock queue by name
+func (c Client) GetKaiSchedulerQueue(name string) (*models.KaiSchedulerQueue, error) {
+	queues, err := c.ListKaiSchedulerQueues()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, q := range queues {
+		if q.Name == name {
+			return &q, nil
+		}
+	}
+
+	return nil, fiber.ErrNotFound
+}
+
+// CreateKaiSchedulerQueue checks that a mock queue does not already exist and returns it
+func (c Client) CreateKaiSchedulerQueue(queue models.KaiSchedulerQueue) (*models.KaiSchedulerQueue, error) {
+	if _, err := c.GetKaiSchedulerQueue(queue.Name); err == nil {
+		return nil, fiber.NewError(fiber.StatusConflict, "kai scheduler queue "+queue.Name+" already exists")
+	}
+	return &queue, nil
+}
+
+// UpdateKaiSchedulerQueue checks that a mock queue exists and that the update is based on its current resourceVersion,
+// the update is returned with its current child queues, status and a new resourceVersion
+func (c Client) UpdateKaiSchedulerQueue(queue models.KaiSchedulerQueue) (*models.KaiSchedulerQueue, error) {
+	existing, err := c.GetKaiSchedulerQueue(queue.Name)
+	if err != nil {
+		return nil, err
+	}
+	if queue.ResourceVersion, err = nextResourceVersion(existing.ResourceVersion, queue.ResourceVersion); err != nil {
+		return nil, err
+	}
+	queue.ChildQueues = existing.ChildQueues
+	queue.Status = existing.Status
+	return &queue, nil
+}
+
+// DeleteKaiSchedulerQueue checks that a mock queue exists
+func (c Client) DeleteKaiSchedulerQueue(name string) error {
+	if _, err := c.GetKaiSchedulerQueue(name); err != nil {
+		return err
+	}
+	return nil
+}
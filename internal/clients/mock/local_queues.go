@@ -44,3 +44,28 @@ func (c Client) GetLocalQueue(namespace, name string) (*models.LocalQueue, error
 
 	return nil, fiber.ErrNotFound
 }
+
+// UpdateLocalQueue checks that a mock local queue exists and that the update is based on its current resourceVersion,
+// the update is returned with its status and a new resourceVersion
+func (c Client) UpdateLocalQueue(namespace string, lq models.LocalQueue) (*models.LocalQueue, error) {
+	existing, err := c.GetLocalQueue(namespace, lq.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if lq.ClusterQueue != existing.ClusterQueue {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "field 'clusterQueue' is immutable")
+	}
+	if lq.ResourceVersion, err = nextResourceVersion(existing.ResourceVersion, lq.ResourceVersion); err != nil {
+		return nil, err
+	}
+
+	lq.AdmittedWorkloads = existing.AdmittedWorkloads
+	lq.Conditions = existing.Conditions
+	lq.FlavorsReservation = existing.FlavorsReservation
+	lq.FlavorsUsage = existing.FlavorsUsage
+	lq.Namespace = existing.Namespace
+	lq.PendingWorkloads = existing.PendingWorkloads
+	lq.ReservingWorkloads = existing.ReservingWorkloads
+	return &lq, nil
+}
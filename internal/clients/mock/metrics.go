@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+	"cmyk/internal/util"
+	"encoding/json"
+	"os"
+)
+
+// ListNodeMetrics reads the mock node metrics
+func (c Client) ListNodeMetrics() ([]models.NodeUsage, error) {
+	data, err := os.ReadFile("./internal/clients/mock/node_metrics.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var list models.NodeMetricsList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+	return util.NodeUsages(list), nil
+}
+
+// ListPodMetrics reads the mock pod metrics, summed over the containers of each pod
+func (c Client) ListPodMetrics() ([]models.PodUsage, error) {
+	data, err := os.ReadFile("./internal/clients/mock/pod_metrics.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var list models.PodMetricsList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+	return util.PodUsages(list), nil
+}
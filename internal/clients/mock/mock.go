@@ -1,8 +1,30 @@
+// Package mock serves the API from the JSON fixtures in this directory. Changes are never written back to the fixtures:
+// a create, update or delete is checked against the fixtures and its result returned, later reads return the fixtures as they are.
 package mock
 
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
 type Client struct {
 }
 
 func New() (*Client, error) {
 	return &Client{}, nil
 }
+
+// nextResourceVersion mimics the optimistic concurrency of the API server: an update based on another resourceVersion
+// than the current one conflicts, a successful update gets a new resourceVersion
+func nextResourceVersion(current, requested string) (string, error) {
+	if requested != "" && requested != current {
+		return "", fiber.NewError(fiber.StatusConflict, "the object has been modified, apply the changes to the latest version and try again")
+	}
+
+	version, err := strconv.ParseInt(current, 10, 64)
+	if err != nil {
+		return current, nil
+	}
+	return strconv.FormatInt(version+1, 10), nil
+}
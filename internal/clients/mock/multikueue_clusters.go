@@ -0,0 +1,58 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+
+	"encoding/json"
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListMultiKueueClusters reads and parses the mock multikueue clusters data from JSON file
+func (c Client) ListMultiKueueClusters() ([]models.MultiKueueCluster, error) {
+	data, err := os.ReadFile("./internal/clients/mock/multikueue_clusters.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.MultiKueueCluster
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetMultiKueueCluster reads and returns a mock multikueue cluster by name
+func (c Client) GetMultiKueueCluster(name string) (*models.MultiKueueCluster, error) {
+	clusters, err := c.ListMultiKueueClusters()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mkc := range clusters {
+		if mkc.Name == name {
+			return &mkc, nil
+		}
+	}
+
+	return nil, fiber.ErrNotFound
+}
+
+// CreateMultiKueueCluster checks that a mock multikueue cluster does not already exist and returns it.
+// The kubeconfig Secret is not checked, so the cluster is returned without an Active condition.
+func (c Client) CreateMultiKueueCluster(mkc models.MultiKueueCluster) (*models.MultiKueueCluster, error) {
+	if _, err := c.GetMultiKueueCluster(mkc.Name); err == nil {
+		return nil, fiber.NewError(fiber.StatusConflict, "multikueue cluster "+mkc.Name+" already exists")
+	}
+	return &mkc, nil
+}
+
+// DeleteMultiKueueCluster checks that a mock multikueue cluster exists
+func (c Client) DeleteMultiKueueCluster(name string) error {
+	if _, err := c.GetMultiKueueCluster(name); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+
+	"encoding/json"
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListMultiKueueConfigs reads and parses the mock multikueue configs data from JSON file
+func (c Client) ListMultiKueueConfigs() ([]models.MultiKueueConfig, error) {
+	data, err := os.ReadFile("./internal/clients/mock/multikueue_configs.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.MultiKueueConfig
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetMultiKueueConfig reads and returns a mock multikueue config by name
+func (c Client) GetMultiKueueConfig(name string) (*models.MultiKueueConfig, error) {
+	configs, err := c.ListMultiKueueConfigs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mkc := range configs {
+		if mkc.Name == name {
+			return &mkc, nil
+		}
+	}
+
+	return nil, fiber.ErrNotFound
+}
+
+// CreateMultiKueueConfig checks that a mock multikueue config does not already exist and returns it
+func (c Client) CreateMultiKueueConfig(mkc models.MultiKueueConfig) (*models.MultiKueueConfig, error) {
+	if _, err := c.GetMultiKueueConfig(mkc.Name); err == nil {
+		return nil, fiber.NewError(fiber.StatusConflict, "multikueue config "+mkc.Name+" already exists")
+	}
+	return &mkc, nil
+}
+
+// UpdateMultiKueueConfig checks that a mock multikueue config exists and that the update is based on its current resourceVersion,
+// the update is returned with a new resourceVersion
+func (c Client) UpdateMultiKueueConfig(mkc models.MultiKueueConfig) (*models.MultiKueueConfig, error) {
+	existing, err := c.GetMultiKueueConfig(mkc.Name)
+	if err != nil {
+		return nil, err
+	}
+	if mkc.ResourceVersion, err = nextResourceVersion(existing.ResourceVersion, mkc.ResourceVersion); err != nil {
+		return nil, err
+	}
+	return &mkc, nil
+}
+
+// DeleteMultiKueueConfig checks that a mock multikueue config exists
+func (c Client) DeleteMultiKueueConfig(name string) error {
+	if _, err := c.GetMultiKueueConfig(name); err != nil {
+		return err
+	}
+	return nil
+}
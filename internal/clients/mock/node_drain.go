@@ -0,0 +1,104 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// SetNodeUnschedulable returns the mock node with the new unschedulable flag
+func (c Client) SetNodeUnschedulable(name string, unschedulable bool) (*models.NodeDetail, error) {
+	node, err := c.GetNode(name)
+	if err != nil {
+		return nil, err
+	}
+	node.Unschedulable = unschedulable
+	return node, nil
+}
+
+// nodeDrainPods returns the mock pods bound to a node as the drain sees them
+func (c Client) nodeDrainPods(name string) ([]models.NodePod, error) {
+	data, err := os.ReadFile("./internal/clients/mock/pods.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var pods models.PodList
+	if err := json.Unmarshal(data, &pods); err != nil {
+		return nil, err
+	}
+
+	var result []models.NodePod
+	for _, p := range pods.Items {
+		if p.Spec.NodeName != name {
+			continue
+		}
+
+		pod := models.NodePod{
+			Name:      p.Metadata.Name,
+			Namespace: p.Metadata.Namespace,
+			Phase:     p.Status.Phase,
+		}
+		if len(p.Metadata.OwnerReferences) > 0 {
+			owner := p.Metadata.OwnerReferences[0]
+			pod.DaemonSet = owner.Kind == "DaemonSet"
+			pod.Owner = owner.Kind + "/" + owner.Name
+		}
+		_, pod.Mirror = p.Metadata.Annotations["kubernetes.io/config.mirror"]
+		for _, v := range p.Spec.Volumes {
+			pod.EmptyDir = pod.EmptyDir || v.EmptyDir != nil
+		}
+		result = append(result, pod)
+	}
+
+	return result, nil
+}
+
+// DrainNode simulates a drain of a mock node, every pod that may be evicted leaves the node at once
+func (c Client) DrainNode(name string, opts models.NodeDrainOptions, progress func(models.NodeDrain)) {
+	drain := models.NodeDrain{
+		Node:      name,
+		Options:   opts,
+		StartedAt: time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+		Status:    models.NodeDrainCompleted,
+	}
+
+	pods, err := c.nodeDrainPods(name)
+	if err != nil {
+		drain.Status = models.NodeDrainFailed
+		drain.Error = err.Error()
+	}
+	for _, p := range pods {
+		pod := models.NodeDrainPod{Name: p.Name, Namespace: p.Namespace}
+		switch {
+		case p.DaemonSet:
+			pod.Reason = "managed by a DaemonSet"
+			drain.Skipped = append(drain.Skipped, pod)
+		case p.Mirror:
+			pod.Reason = "static pod"
+			drain.Skipped = append(drain.Skipped, pod)
+		case p.Phase == "Succeeded" || p.Phase == "Failed":
+			pod.Reason = "finished"
+			drain.Skipped = append(drain.Skipped, pod)
+		case p.Owner == "" && !opts.Force:
+			pod.Reason = "not managed by a controller, it would not be recreated, drain with force to evict it"
+			drain.Blocked = append(drain.Blocked, pod)
+			drain.Remaining = append(drain.Remaining, pod)
+		case p.EmptyDir && !opts.DeleteEmptyDirData:
+			pod.Reason = "has emptyDir volumes whose data would be deleted, drain with deleteEmptyDirData to evict it"
+			drain.Blocked = append(drain.Blocked, pod)
+			drain.Remaining = append(drain.Remaining, pod)
+		default:
+			drain.Evicted = append(drain.Evicted, pod)
+		}
+	}
+	if len(drain.Blocked) > 0 {
+		drain.Status = models.NodeDrainFailed
+		drain.Error = fmt.Sprintf("%d pods are only evicted with force or deleteEmptyDirData", len(drain.Blocked))
+	}
+
+	drain.CompletedAt = time.Now().UTC().Format("2006-01-02T15:04:05Z")
+	progress(drain)
+}
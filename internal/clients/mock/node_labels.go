@@ -0,0 +1,29 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+)
+
+// UpdateNode returns the mock node with the changed labels and taints
+func (c Client) UpdateNode(name string, labels map[string]*string, mergeTaints func([]models.NodeTaint) []models.NodeTaint) (*models.NodeDetail, error) {
+	node, err := c.GetNode(name)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range labels {
+		if value == nil {
+			delete(node.Labels, key)
+			continue
+		}
+		if node.Labels == nil {
+			node.Labels = make(map[string]string)
+		}
+		node.Labels[key] = *value
+	}
+	if mergeTaints != nil {
+		node.Taints = mergeTaints(node.Taints)
+	}
+
+	return node, nil
+}
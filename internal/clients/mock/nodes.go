@@ -54,14 +54,28 @@ func (c Client) ListNodes() ([]models.Node, error) {
 			roleStr = "<none>"
 		}
 
+		var taints []models.NodeTaint
+		for _, t := range n.Spec.Taints {
+			taints = append(taints, models.NodeTaint{
+				Effect:    t.Effect,
+				Key:       t.Key,
+				TimeAdded: t.TimeAdded,
+				Value:     t.Value,
+			})
+		}
+
 		result = append(result, models.Node{
+			Allocatable:    models.NodeResources(n.Status.Allocatable),
 			CPU:            n.Status.Capacity["cpu"],
+			Capacity:       models.NodeResources(n.Status.Capacity),
 			IP:             ip,
 			KubeletVersion: n.Status.NodeInfo.KubeletVersion,
+			Labels:         n.Metadata.Labels,
 			Memory:         n.Status.Capacity["memory"],
 			Name:           n.Metadata.Name,
 			Ready:          ready,
 			Roles:          roleStr,
+			Taints:         taints,
 		})
 	}
 
@@ -110,9 +124,10 @@ func (c Client) GetNode(name string) (*models.NodeDetail, error) {
 		var taints []models.NodeTaint
 		for _, t := range n.Spec.Taints {
 			taints = append(taints, models.NodeTaint{
-				Key:    t.Key,
-				Value:  t.Value,
-				Effect: t.Effect,
+				Effect:    t.Effect,
+				Key:       t.Key,
+				TimeAdded: t.TimeAdded,
+				Value:     t.Value,
 			})
 		}
 
@@ -166,11 +181,12 @@ func (c Client) GetNode(name string) (*models.NodeDetail, error) {
 				OSImage:                 n.Status.NodeInfo.OSImage,
 				SystemUUID:              n.Status.NodeInfo.SystemUUID,
 			},
-			PodCIDR: n.Spec.PodCIDR,
-			Ready:   ready,
-			Roles:   roleStr,
-			Taints:  taints,
-			UID:     n.Metadata.UID,
+			PodCIDR:       n.Spec.PodCIDR,
+			Ready:         ready,
+			Roles:         roleStr,
+			Taints:        taints,
+			UID:           n.Metadata.UID,
+			Unschedulable: n.Spec.Unschedulable,
 		}, nil
 	}
 
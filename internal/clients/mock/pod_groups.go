@@ -0,0 +1,62 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+
+	"encoding/json"
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func loadPodGroups() ([]models.PodGroup, error) {
+	data, err := os.ReadFile("./internal/clients/mock/pod_groups.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var podGroups []models.PodGroup
+	if err := json.Unmarshal(data, &podGroups); err != nil {
+		return nil, err
+	}
+	return podGroups, nil
+}
+
+// ListPodGroups reads and parses the mock pod groups data from JSON file, optionally filtered by namespace and queue.
+// Pods are only returned with the detail of a pod group.
+func (c Client) ListPodGroups(namespace, queue string) ([]models.PodGroup, error) {
+	podGroups, err := loadPodGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.PodGroup
+	for _, pg := range podGroups {
+		if namespace != "" && pg.Namespace != namespace {
+			continue
+		}
+		if queue != "" && pg.Queue != queue {
+			continue
+		}
+		pg.Pods = nil
+		result = append(result, pg)
+	}
+
+	return result, nil
+}
+
+// GetPodGroup reads and returns a single mock pod group with its pods
+func (c Client) GetPodGroup(namespace, name string) (*models.PodGroup, error) {
+	podGroups, err := loadPodGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pg := range podGroups {
+		if pg.Namespace == namespace && pg.Name == name {
+			return &pg, nil
+		}
+	}
+
+	return nil, fiber.ErrNotFound
+}
@@ -0,0 +1,94 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// podLogReplayInterval is the delay between lines when following mock logs
+const podLogReplayInterval = 250 * time.Millisecond
+
+type podLogs struct {
+	Container string   `json:"container"`
+	Lines     []string `json:"lines"`
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace"`
+}
+
+// StreamPodLogs replays the canned logs of a pod container, one line at a time when following.
+// The lines are one second apart up to now, mock containers never restarted so there are no previous logs.
+func (c Client) StreamPodLogs(namespace, name string, opts models.PodLogOptions) (io.ReadCloser, error) {
+	data, err := os.ReadFile("./internal/clients/mock/pod_logs.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var all []podLogs
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	var logs *podLogs
+	for i := range all {
+		if all[i].Namespace == namespace && all[i].Name == name {
+			logs = &all[i]
+			break
+		}
+	}
+	if logs == nil {
+		return nil, fiber.ErrNotFound
+	}
+	if opts.Container != "" && opts.Container != logs.Container {
+		return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("container %s is not valid for pod %s", opts.Container, name))
+	}
+
+	if opts.Previous {
+		return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("previous terminated container %s in pod %s not found", logs.Container, name))
+	}
+
+	lines := logs.Lines
+	if opts.SinceSeconds != nil && int(*opts.SinceSeconds) < len(lines) {
+		lines = lines[len(lines)-int(*opts.SinceSeconds):]
+	}
+	if opts.TailLines != nil && int(*opts.TailLines) < len(lines) {
+		lines = lines[len(lines)-int(*opts.TailLines):]
+	}
+
+	// Timestamps are spread backwards from now so the replayed lines look recent
+	start := time.Now().UTC().Add(-time.Duration(len(lines)) * time.Second)
+	format := func(i int, line string) string {
+		if opts.Timestamps {
+			return start.Add(time.Duration(i)*time.Second).Format(time.RFC3339Nano) + " " + line + "\n"
+		}
+		return line + "\n"
+	}
+
+	if !opts.Follow {
+		var b strings.Builder
+		for i, line := range lines {
+			b.WriteString(format(i, line))
+		}
+		return io.NopCloser(strings.NewReader(b.String())), nil
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		ticker := time.NewTicker(podLogReplayInterval)
+		defer ticker.Stop()
+		for i, line := range lines {
+			<-ticker.C
+			if _, err := io.WriteString(pw, format(i, line)); err != nil {
+				return
+			}
+		}
+		pw.Close()
+	}()
+	return pr, nil
+}
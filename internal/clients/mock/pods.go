@@ -2,11 +2,15 @@ package mock
 
 import (
 	"cmyk/internal/models"
+	"cmyk/internal/util"
 	"fmt"
 
 	"encoding/json"
 	"os"
 	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // ListPods reads and parses the mock pods data from JSON file
@@ -45,6 +49,8 @@ func (c Client) ListPods() ([]models.Pod, error) {
 			StatusClass: statusClass,
 			Node:        p.Spec.NodeName,
 			PodIP:       p.Status.PodIP,
+			Limits:      podLimits(p),
+			Requests:    podRequests(p),
 			Restarts:    restarts,
 		})
 	}
@@ -52,6 +58,22 @@ func (c Client) ListPods() ([]models.Pod, error) {
 	return result, nil
 }
 
+// ListPodsOnNode returns the mock pods bound to a node
+func (c Client) ListPodsOnNode(name string) ([]models.Pod, error) {
+	pods, err := c.ListPods()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.Pod
+	for _, p := range pods {
+		if p.Node == name {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
 // GetPod reads and parses a single pod from mock data
 //
 //revive:disable:cyclomatic
@@ -248,3 +270,50 @@ func (c Client) GetPod(namespace, name string) (*models.PodDetail, error) {
 	return nil, fmt.Errorf("pod %s/%s not found", namespace, name)
 	//revive:enable:cyclomatic
 }
+
+// podRequests returns the resources requested by a mock pod, counted the same way as the pods of a cluster
+func podRequests(p models.PodItem) map[string]string {
+	var containers, initContainers []corev1.ResourceList
+	for _, c := range p.Spec.Containers {
+		containers = append(containers, toResourceList(c.Resources.Requests))
+	}
+	for _, c := range p.Spec.InitContainers {
+		initContainers = append(initContainers, toResourceList(c.Resources.Requests))
+	}
+	return toResourceListModel(util.PodResources(containers, initContainers, toResourceList(p.Spec.Overhead)))
+}
+
+// podLimits returns the resource limits of a mock pod, counted the same way as its requests
+func podLimits(p models.PodItem) map[string]string {
+	var containers, initContainers []corev1.ResourceList
+	for _, c := range p.Spec.Containers {
+		containers = append(containers, toResourceList(c.Resources.Limits))
+	}
+	for _, c := range p.Spec.InitContainers {
+		initContainers = append(initContainers, toResourceList(c.Resources.Limits))
+	}
+	return toResourceListModel(util.PodResources(containers, initContainers, toResourceList(p.Spec.Overhead)))
+}
+
+// toResourceList parses the quantities of a mock resource list, malformed quantities are left out
+func toResourceList(list map[string]string) corev1.ResourceList {
+	result := make(corev1.ResourceList, len(list))
+	for name, value := range list {
+		if q, err := resource.ParseQuantity(value); err == nil {
+			result[corev1.ResourceName(name)] = q
+		}
+	}
+	return result
+}
+
+func toResourceListModel(list corev1.ResourceList) map[string]string {
+	if len(list) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(list))
+	for name, q := range list {
+		result[string(name)] = q.String()
+	}
+	return result
+}
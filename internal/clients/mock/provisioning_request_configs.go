@@ -0,0 +1,57 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+
+	"encoding/json"
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListProvisioningRequestConfigs reads and parses the mock provisioning request configs data from JSON file
+func (c Client) ListProvisioningRequestConfigs() ([]models.ProvisioningRequestConfig, error) {
+	data, err := os.ReadFile("./internal/clients/mock/provisioning_request_configs.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.ProvisioningRequestConfig
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetProvisioningRequestConfig reads and returns a mock provisioning request config by name
+func (c Client) GetProvisioningRequestConfig(name string) (*models.ProvisioningRequestConfig, error) {
+	configs, err := c.ListProvisioningRequestConfigs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, prc := range configs {
+		if prc.Name == name {
+			return &prc, nil
+		}
+	}
+
+	return nil, fiber.ErrNotFound
+}
+
+// CreateProvisioningRequestConfig checks that a mock provisioning request config does not already exist and returns it
+func (c Client) CreateProvisioningRequestConfig(prc models.ProvisioningRequestConfig) (*models.ProvisioningRequestConfig, error) {
+	if _, err := c.GetProvisioningRequestConfig(prc.Name); err == nil {
+		return nil, fiber.NewError(fiber.StatusConflict, "provisioning request config "+prc.Name+" already exists")
+	}
+	return &prc, nil
+}
+
+// DeleteProvisioningRequestConfig checks that a mock provisioning request config exists
+func (c Client) DeleteProvisioningRequestConfig(name string) error {
+	if _, err := c.GetProvisioningRequestConfig(name); err != nil {
+		return err
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"encoding/json"
 	"os"
+	"reflect"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -44,3 +45,26 @@ func (c Client) GetResourceFlavor(name string) (*models.ResourceFlavor, error) {
 
 	return nil, fiber.ErrNotFound
 }
+
+// UpdateResourceFlavor checks that a mock resource flavor exists and that the update is based on its current resourceVersion,
+// the update is returned with a new resourceVersion
+func (c Client) UpdateResourceFlavor(rf models.ResourceFlavor) (*models.ResourceFlavor, error) {
+	existing, err := c.GetResourceFlavor(rf.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Kueue rejects any change to a flavor once it is part of a topology
+	if existing.TopologyName != "" {
+		current, requested := *existing, rf
+		current.ResourceVersion, requested.ResourceVersion = "", ""
+		if !reflect.DeepEqual(current, requested) {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "resource flavor spec is immutable when topologyName is set")
+		}
+	}
+	if rf.ResourceVersion, err = nextResourceVersion(existing.ResourceVersion, rf.ResourceVersion); err != nil {
+		return nil, err
+	}
+
+	return &rf, nil
+}
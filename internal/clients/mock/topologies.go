@@ -0,0 +1,57 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+
+	"encoding/json"
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListTopologies reads and parses the mock topologies data from JSON file
+func (c Client) ListTopologies() ([]models.Topology, error) {
+	data, err := os.ReadFile("./internal/clients/mock/topologies.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.Topology
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetTopology reads and returns a mock topology by name
+func (c Client) GetTopology(name string) (*models.Topology, error) {
+	topologies, err := c.ListTopologies()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range topologies {
+		if t.Name == name {
+			return &t, nil
+		}
+	}
+
+	return nil, fiber.ErrNotFound
+}
+
+// CreateTopology checks that a mock topology does not already exist and returns it
+func (c Client) CreateTopology(t models.Topology) (*models.Topology, error) {
+	if _, err := c.GetTopology(t.Name); err == nil {
+		return nil, fiber.NewError(fiber.StatusConflict, "topology "+t.Name+" already exists")
+	}
+	return &t, nil
+}
+
+// DeleteTopology checks that a mock topology exists
+func (c Client) DeleteTopology(name string) error {
+	if _, err := c.GetTopology(name); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+
+	"encoding/json"
+	"os"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListWorkloadPriorityClasses reads and parses the mock workload priority classes data from JSON file
+func (c Client) ListWorkloadPriorityClasses() ([]models.WorkloadPriorityClass, error) {
+	data, err := os.ReadFile("./internal/clients/mock/workload_priority_classes.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.WorkloadPriorityClass
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetWorkloadPriorityClass reads and returns a mock workload priority class by name
+func (c Client) GetWorkloadPriorityClass(name string) (*models.WorkloadPriorityClass, error) {
+	classes, err := c.ListWorkloadPriorityClasses()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pc := range classes {
+		if pc.Name == name {
+			return &pc, nil
+		}
+	}
+
+	return nil, fiber.ErrNotFound
+}
+
+// CreateWorkloadPriorityClass checks that a mock workload priority class does not already exist and returns it
+func (c Client) CreateWorkloadPriorityClass(pc models.WorkloadPriorityClass) (*models.WorkloadPriorityClass, error) {
+	if _, err := c.GetWorkloadPriorityClass(pc.Name); err == nil {
+		return nil, fiber.NewError(fiber.StatusConflict, "workload priority class "+pc.Name+" already exists")
+	}
+	return &pc, nil
+}
+
+// UpdateWorkloadPriorityClass checks that a mock workload priority class exists and that the update is based on its current resourceVersion,
+// the update is returned with a new resourceVersion
+func (c Client) UpdateWorkloadPriorityClass(pc models.WorkloadPriorityClass) (*models.WorkloadPriorityClass, error) {
+	existing, err := c.GetWorkloadPriorityClass(pc.Name)
+	if err != nil {
+		return nil, err
+	}
+	if pc.ResourceVersion, err = nextResourceVersion(existing.ResourceVersion, pc.ResourceVersion); err != nil {
+		return nil, err
+	}
+	return &pc, nil
+}
+
+// DeleteWorkloadPriorityClass checks that a mock workload priority class exists
+func (c Client) DeleteWorkloadPriorityClass(name string) error {
+	if _, err := c.GetWorkloadPriorityClass(name); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package mock
+
+import (
+	"cmyk/internal/models"
+
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListWorkloads reads and returns mock workloads, optionally filtered by namespace, LocalQueue, ClusterQueue, state and MultiKueue worker cluster
+func (c Client) ListWorkloads(namespace, localQueue, clusterQueue, state, cluster string) ([]models.Workload, error) {
+	data, err := os.ReadFile("./internal/clients/mock/workloads.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var workloads []models.Workload
+	if err := json.Unmarshal(data, &workloads); err != nil {
+		return nil, err
+	}
+
+	queueToCQ := make(map[string]string)
+	if clusterQueue != "" {
+		lqs, err := c.ListLocalQueues()
+		if err != nil {
+			return nil, err
+		}
+		for _, lq := range lqs {
+			queueToCQ[lq.Namespace+"/"+lq.Name] = lq.ClusterQueue
+		}
+	}
+
+	var result []models.Workload
+	for _, wl := range workloads {
+		if namespace != "" && wl.Namespace != namespace {
+			continue
+		}
+		if localQueue != "" && wl.Queue != localQueue {
+			continue
+		}
+		if clusterQueue != "" {
+			cq := wl.ClusterQueue
+			if cq == "" {
+				cq = queueToCQ[wl.Namespace+"/"+wl.Queue]
+			}
+			if cq != clusterQueue {
+				continue
+			}
+		}
+		if state != "" && !strings.EqualFold(wl.State, state) {
+			continue
+		}
+		if cluster != "" && wl.ClusterName != cluster {
+			continue
+		}
+		result = append(result, wl)
+	}
+
+	return result, nil
+}
+
+// GetWorkload reads and returns a mock workload by namespace and name
+func (c Client) GetWorkload(namespace, name string) (*models.Workload, error) {
+	workloads, err := c.ListWorkloads(namespace, "", "", "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, wl := range workloads {
+		if wl.Name == name {
+			return &wl, nil
+		}
+	}
+
+	return nil, fiber.ErrNotFound
+}
@@ -0,0 +1,365 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// ReadAdmissionChecks returns admission checks as JSON
+// @Description Get Kueue admission checks with their Active condition. Workloads in a ClusterQueue with an inactive check keep their quota reservation but are not admitted.
+// @Summary Get admission checks
+// @Tags AdmissionChecks
+// @Produce json
+// @Success 200 {array} models.AdmissionCheck
+// @Success 204
+// @Router /api/v1/admission-checks [get]
+func (h Handlers) ReadAdmissionChecks(c *fiber.Ctx) error {
+	var checks []models.AdmissionCheck
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		checks, err = h.MockClient.ListAdmissionChecks()
+	} else {
+		checks, err = h.K8sClient.ListAdmissionChecks()
+	}
+	if err != nil {
+		log.Printf("failed reading admission checks: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading admission checks"})
+	}
+	if len(checks) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+	return c.JSON(checks)
+}
+
+// ReadAdmissionCheckDetail returns admission check detail as JSON
+// @Description Get admission check detail with its Active condition
+// @Summary Get admission check detail
+// @Tags AdmissionChecks
+// @Produce json
+// @Param name path string true "AdmissionCheck name"
+// @Success 200 {object} models.AdmissionCheck
+// @Failure 404 {object} models.Error
+// @Router /api/v1/admission-checks/{name} [get]
+func (h Handlers) ReadAdmissionCheckDetail(c *fiber.Ctx) error {
+	var check *models.AdmissionCheck
+	var err error
+
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		check, err = h.MockClient.GetAdmissionCheck(name)
+	} else {
+		check, err = h.K8sClient.GetAdmissionCheck(name)
+	}
+	if err != nil {
+		log.Printf("failed reading admission check: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Admission check not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading admission check"})
+	}
+	return c.JSON(check)
+}
+
+// CreateAdmissionCheck creates a new admission check
+// @Description Create a new Kueue admission check. Checks for the provisioning request controller must reference an existing ProvisioningRequestConfig,
+// @Description checks for the multikueue controller an existing MultiKueueConfig.
+// @Summary Create admission check
+// @Tags AdmissionChecks
+// @Accept json
+// @Produce json
+// @Param admissionCheck body models.AdmissionCheck true "AdmissionCheck to create"
+// @Success 201 {object} models.AdmissionCheck
+// @Failure 400 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/admission-checks [post]
+func (h Handlers) CreateAdmissionCheck(c *fiber.Ctx) error {
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	check, err := validateAdmissionCheckSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	if p := check.Parameters; p != nil && p.APIGroup == kueuev1beta2.GroupVersion.Group && p.Kind == "ProvisioningRequestConfig" {
+		if h.EnvClient.IsMockMode() {
+			_, err = h.MockClient.GetProvisioningRequestConfig(p.Name)
+		} else {
+			_, err = h.K8sClient.GetProvisioningRequestConfig(p.Name)
+		}
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return sendBadRequest(c, fmt.Sprintf("provisioning request config '%s' does not exist", p.Name))
+		}
+		if err != nil {
+			log.Printf("failed checking admission check parameters: %v", err)
+			return sendClientError(c, "Provisioning request config not found", err)
+		}
+	}
+
+	if p := check.Parameters; p != nil && p.APIGroup == kueuev1beta2.GroupVersion.Group && p.Kind == "MultiKueueConfig" {
+		if h.EnvClient.IsMockMode() {
+			_, err = h.MockClient.GetMultiKueueConfig(p.Name)
+		} else {
+			_, err = h.K8sClient.GetMultiKueueConfig(p.Name)
+		}
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return sendBadRequest(c, fmt.Sprintf("multikueue config '%s' does not exist", p.Name))
+		}
+		if err != nil {
+			log.Printf("failed checking admission check parameters: %v", err)
+			return sendClientError(c, "Multikueue config not found", err)
+		}
+	}
+
+	var created *models.AdmissionCheck
+	if h.EnvClient.IsMockMode() {
+		created, err = h.MockClient.CreateAdmissionCheck(*check)
+	} else {
+		created, err = h.K8sClient.CreateAdmissionCheck(*check)
+	}
+	if err != nil {
+		log.Printf("failed creating admission check: %v", err)
+		return sendClientError(c, "Admission check not found", err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(created)
+}
+
+// DeleteAdmissionCheck deletes an admission check
+// @Description Delete an admission check. Kueue keeps it until no ClusterQueue references it.
+// @Summary Delete admission check
+// @Tags AdmissionChecks
+// @Produce json
+// @Param name path string true "AdmissionCheck name"
+// @Success 204
+// @Failure 404 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/admission-checks/{name} [delete]
+func (h Handlers) DeleteAdmissionCheck(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var err error
+	if h.EnvClient.IsMockMode() {
+		err = h.MockClient.DeleteAdmissionCheck(name)
+	} else {
+		err = h.K8sClient.DeleteAdmissionCheck(name)
+	}
+	if err != nil {
+		log.Printf("failed deleting admission check: %v", err)
+		return sendClientError(c, "Admission check not found", err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// UpdateClusterQueueAdmissionChecks replaces the admission checks of a cluster queue
+// @Description Replace the admission checks attached to a cluster queue, the rest of its spec is left unchanged.
+// @Description Every admission check must exist and onFlavors may only name flavors of the cluster queue. An empty list detaches all checks.
+// @Summary Attach admission checks to cluster queue
+// @Tags ClusterQueues
+// @Accept json
+// @Produce json
+// @Param name path string true "ClusterQueue name"
+// @Param admissionChecks body []models.ClusterQueueAdmissionCheck true "Admission checks"
+// @Success 200 {object} models.ClusterQueue
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/cluster-queues/{name}/admission-checks [put]
+func (h Handlers) UpdateClusterQueueAdmissionChecks(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+	if err := checkAllowedFields(rawBody, "admissionChecks"); err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+	if _, exists := rawBody["admissionChecks"]; !exists {
+		return sendBadRequest(c, "field 'admissionChecks' is required")
+	}
+
+	var cq *models.ClusterQueue
+	var err error
+	if h.EnvClient.IsMockMode() {
+		cq, err = h.MockClient.GetClusterQueue(name)
+	} else {
+		cq, err = h.K8sClient.GetClusterQueue(name)
+	}
+	if err != nil {
+		log.Printf("failed reading cluster queue: %v", err)
+		return sendClientError(c, "Cluster queue not found", err)
+	}
+
+	checks, err := validateClusterQueueAdmissionChecksSchema(rawBody, cq.ResourceGroups)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	missing, err := h.missingAdmissionChecks(checks)
+	if err != nil {
+		log.Printf("failed checking cluster queue admission checks: %v", err)
+		return sendClientError(c, "Admission check not found", err)
+	}
+	if len(missing) > 0 {
+		return sendBadRequest(c, "admission checks not found: "+strings.Join(missing, ", "))
+	}
+
+	var updated *models.ClusterQueue
+	if h.EnvClient.IsMockMode() {
+		updated, err = h.MockClient.SetClusterQueueAdmissionChecks(name, checks)
+	} else {
+		updated, err = h.K8sClient.SetClusterQueueAdmissionChecks(name, checks)
+	}
+	if err != nil {
+		log.Printf("failed updating cluster queue admission checks: %v", err)
+		return sendClientError(c, "Cluster queue not found", err)
+	}
+
+	return c.JSON(updated)
+}
+
+// missingAdmissionChecks returns the AdmissionChecks attached to a cluster queue that do not exist
+func (h Handlers) missingAdmissionChecks(checks []models.ClusterQueueAdmissionCheck) ([]string, error) {
+	if len(checks) == 0 {
+		return nil, nil
+	}
+
+	var existing []models.AdmissionCheck
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		existing, err = h.MockClient.ListAdmissionChecks()
+	} else {
+		existing, err = h.K8sClient.ListAdmissionChecks()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed reading admission checks: %w", err)
+	}
+
+	var missing []string
+	for _, ac := range checks {
+		found := slices.ContainsFunc(existing, func(e models.AdmissionCheck) bool { return e.Name == ac.Name })
+		if !found && !slices.Contains(missing, ac.Name) {
+			missing = append(missing, ac.Name)
+		}
+	}
+	return missing, nil
+}
+
+// validateAdmissionCheckSchema validates the admission check creation request.
+// The parameters of provisioning request and multikueue checks default to a ProvisioningRequestConfig or MultiKueueConfig reference.
+func validateAdmissionCheckSchema(rawBody map[string]any) (*models.AdmissionCheck, error) {
+	err := checkAllowedFields(rawBody, "controllerName", "name", "parameters")
+	if err != nil {
+		return nil, err
+	}
+
+	check := &models.AdmissionCheck{}
+
+	if check.Name, err = requiredString(rawBody, "name"); err != nil {
+		return nil, err
+	}
+
+	if check.ControllerName, err = requiredString(rawBody, "controllerName"); err != nil {
+		return nil, err
+	}
+
+	params, err := optionalObject(rawBody, "parameters")
+	if err != nil {
+		return nil, err
+	}
+	if params != nil {
+		if err := checkAllowedFields(params, "apiGroup", "kind", "name"); err != nil {
+			return nil, err
+		}
+		check.Parameters = &models.AdmissionCheckParameters{}
+		if check.Parameters.Name, err = requiredString(params, "name"); err != nil {
+			return nil, err
+		}
+		if check.Parameters.APIGroup, err = optionalString(params, "apiGroup"); err != nil {
+			return nil, err
+		}
+		if check.Parameters.Kind, err = optionalString(params, "kind"); err != nil {
+			return nil, err
+		}
+	}
+
+	defaultKinds := map[string]string{
+		kueuev1beta2.MultiKueueControllerName:          "MultiKueueConfig",
+		kueuev1beta2.ProvisioningRequestControllerName: "ProvisioningRequestConfig",
+	}
+	if kind, ok := defaultKinds[check.ControllerName]; ok {
+		if check.Parameters == nil {
+			return nil, fmt.Errorf("field 'parameters' is required for controller %s", check.ControllerName)
+		}
+		if check.Parameters.APIGroup == "" {
+			check.Parameters.APIGroup = kueuev1beta2.GroupVersion.Group
+		}
+		if check.Parameters.Kind == "" {
+			check.Parameters.Kind = kind
+		}
+	} else if check.Parameters != nil && (check.Parameters.APIGroup == "" || check.Parameters.Kind == "") {
+		return nil, fmt.Errorf("fields 'parameters.apiGroup' and 'parameters.kind' are required")
+	}
+
+	return check, nil
+}
+
+// validateClusterQueueAdmissionChecksSchema validates the admission checks attached to a cluster queue,
+// onFlavors may only name flavors of the given resource groups
+func validateClusterQueueAdmissionChecksSchema(rawBody map[string]any, groups []models.ResourceGroup) ([]models.ClusterQueueAdmissionCheck, error) {
+	items, err := optionalObjectSlice(rawBody, "admissionChecks")
+	if err != nil {
+		return nil, err
+	}
+
+	var flavors []string
+	for _, rg := range groups {
+		for _, f := range rg.Flavors {
+			flavors = append(flavors, f.Name)
+		}
+	}
+
+	var result []models.ClusterQueueAdmissionCheck
+	for _, item := range items {
+		if err := checkAllowedFields(item, "name", "onFlavors"); err != nil {
+			return nil, err
+		}
+
+		ac := models.ClusterQueueAdmissionCheck{}
+		if ac.Name, err = requiredString(item, "name"); err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(result, func(r models.ClusterQueueAdmissionCheck) bool { return r.Name == ac.Name }) {
+			return nil, fmt.Errorf("admission check '%s' is listed more than once", ac.Name)
+		}
+
+		if ac.OnFlavors, err = optionalStringSlice(item, "onFlavors"); err != nil {
+			return nil, err
+		}
+		for _, f := range ac.OnFlavors {
+			if !slices.Contains(flavors, f) {
+				return nil, fmt.Errorf("admission check '%s' references flavor '%s' which is not in the cluster queue resource groups", ac.Name, f)
+			}
+		}
+
+		result = append(result, ac)
+	}
+	return result, nil
+}
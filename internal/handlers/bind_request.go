@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ReadBindRequests returns KAI Scheduler BindRequests as JSON
+// @Description Get KAI Scheduler BindRequests, the binds of pods to the node the scheduler selected, with the GPUs they receive.
+// @Description A bind can still fail after the node is chosen, failed binds report the reason and the number of failed attempts.
+// @Summary Get bind requests
+// @Tags BindRequests
+// @Produce json
+// @Param namespace query string false "BindRequest namespace"
+// @Param pod query string false "Pod name"
+// @Param node query string false "Selected node name"
+// @Param phase query string false "BindRequest phase (Pending, Succeeded, Failed)"
+// @Success 200 {array} models.BindRequest
+// @Success 204
+// @Router /api/v1/bind-requests [get]
+func (h Handlers) ReadBindRequests(c *fiber.Ctx) error {
+	var bindRequests []models.BindRequest
+	var err error
+
+	namespace := c.Query("namespace")
+	pod := c.Query("pod")
+	node := c.Query("node")
+	phase := c.Query("phase")
+
+	if h.EnvClient.IsMockMode() {
+		bindRequests, err = h.MockClient.ListBindRequests(namespace, pod, node, phase)
+	} else {
+		bindRequests, err = h.K8sClient.ListBindRequests(namespace, pod, node, phase)
+	}
+	if err != nil {
+		log.Printf("failed reading bind requests: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading bind requests"})
+	}
+	if len(bindRequests) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+	return c.JSON(bindRequests)
+}
+
+// ReadBindRequestDetail returns KAI Scheduler BindRequest detail as JSON
+// @Description Get KAI Scheduler BindRequest detail with the selected node, received GPUs, phase and failure reason
+// @Summary Get bind request detail
+// @Tags BindRequests
+// @Produce json
+// @Param namespace path string true "BindRequest namespace"
+// @Param name path string true "BindRequest name"
+// @Success 200 {object} models.BindRequest
+// @Failure 404 {object} models.Error
+// @Router /api/v1/namespaces/{namespace}/bind-requests/{name} [get]
+func (h Handlers) ReadBindRequestDetail(c *fiber.Ctx) error {
+	var bindRequest *models.BindRequest
+	var err error
+
+	namespace := c.Params("namespace")
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		bindRequest, err = h.MockClient.GetBindRequest(namespace, name)
+	} else {
+		bindRequest, err = h.K8sClient.GetBindRequest(namespace, name)
+	}
+	if err != nil {
+		log.Printf("failed reading bind request: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Bind request not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading bind request"})
+	}
+	return c.JSON(bindRequest)
+}
@@ -0,0 +1,522 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ReadClusterQueues returns cluster queues as JSON
+// @Description Get cluster queues
+// @Summary Get cluster queues
+// @Tags ClusterQueues
+// @Produce json
+// @Success 200 {array} models.ClusterQueue
+// @Success 204
+// @Router /api/v1/cluster-queues [get]
+func (h Handlers) ReadClusterQueues(c *fiber.Ctx) error {
+	var queues []models.ClusterQueue
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		queues, err = h.MockClient.ListClusterQueues()
+	} else {
+		queues, err = h.K8sClient.ListClusterQueues()
+	}
+	if err != nil {
+		log.Printf("failed reading cluster queues: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading cluster queues"})
+	}
+	if len(queues) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+	return c.JSON(queues)
+}
+
+// ReadClusterQueueDetail returns cluster queue detail as JSON
+// @Description Get cluster queue detail with its quotas, usage and conditions
+// @Summary Get cluster queue detail
+// @Tags ClusterQueues
+// @Produce json
+// @Param name path string true "ClusterQueue name"
+// @Success 200 {object} models.ClusterQueue
+// @Failure 404 {object} models.Error
+// @Router /api/v1/cluster-queues/{name} [get]
+func (h Handlers) ReadClusterQueueDetail(c *fiber.Ctx) error {
+	var queue *models.ClusterQueue
+	var err error
+
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		queue, err = h.MockClient.GetClusterQueue(name)
+	} else {
+		queue, err = h.K8sClient.GetClusterQueue(name)
+	}
+	if err != nil {
+		log.Printf("failed reading cluster queue: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Cluster queue not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading cluster queue"})
+	}
+	return c.JSON(queue)
+}
+
+// CreateClusterQueue creates a new cluster queue
+// @Description Create a new cluster queue. Every ResourceFlavor referenced by its resource groups and every attached AdmissionCheck must exist.
+// @Description A missing namespaceSelector admits no namespaces, use {} to admit all of them.
+// @Summary Create cluster queue
+// @Tags ClusterQueues
+// @Accept json
+// @Produce json
+// @Param clusterQueue body models.ClusterQueue true "ClusterQueue to create"
+// @Success 201 {object} models.ClusterQueue
+// @Failure 400 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/cluster-queues [post]
+func (h Handlers) CreateClusterQueue(c *fiber.Ctx) error {
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	cq, err := validateClusterQueueSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	missing, err := h.missingResourceFlavors(cq.ResourceGroups)
+	if err != nil {
+		log.Printf("failed checking cluster queue flavors: %v", err)
+		return sendClientError(c, "Resource flavor not found", err)
+	}
+	if len(missing) > 0 {
+		return sendBadRequest(c, "resource flavors not found: "+strings.Join(missing, ", "))
+	}
+
+	if missing, err = h.missingAdmissionChecks(cq.AdmissionChecks); err != nil {
+		log.Printf("failed checking cluster queue admission checks: %v", err)
+		return sendClientError(c, "Admission check not found", err)
+	}
+	if len(missing) > 0 {
+		return sendBadRequest(c, "admission checks not found: "+strings.Join(missing, ", "))
+	}
+
+	var created *models.ClusterQueue
+	if h.EnvClient.IsMockMode() {
+		created, err = h.MockClient.CreateClusterQueue(*cq)
+	} else {
+		created, err = h.K8sClient.CreateClusterQueue(*cq)
+	}
+	if err != nil {
+		log.Printf("failed creating cluster queue: %v", err)
+		return sendClientError(c, "Cluster queue not found", err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(created)
+}
+
+// UpdateClusterQueue replaces the spec of a cluster queue
+// @Description Replace the resource groups, cohort, namespace selector, preemption, queueing strategy, stop policy and admission checks of a cluster queue.
+// @Description Every ResourceFlavor referenced by its resource groups and every attached AdmissionCheck must exist.
+// @Description When resourceVersion is given and the cluster queue has been modified since, 409 is returned.
+// @Summary Update cluster queue
+// @Tags ClusterQueues
+// @Accept json
+// @Produce json
+// @Param name path string true "ClusterQueue name"
+// @Param clusterQueue body models.ClusterQueue true "ClusterQueue spec"
+// @Success 200 {object} models.ClusterQueue
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/cluster-queues/{name} [put]
+func (h Handlers) UpdateClusterQueue(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	if _, exists := rawBody["name"]; !exists {
+		rawBody["name"] = name
+	}
+
+	resourceVersion, err := optionalString(rawBody, "resourceVersion")
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+	delete(rawBody, "resourceVersion")
+
+	cq, err := validateClusterQueueSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+	if cq.Name != name {
+		return sendBadRequest(c, "field 'name' cannot be changed")
+	}
+	cq.ResourceVersion = resourceVersion
+
+	missing, err := h.missingResourceFlavors(cq.ResourceGroups)
+	if err != nil {
+		log.Printf("failed checking cluster queue flavors: %v", err)
+		return sendClientError(c, "Resource flavor not found", err)
+	}
+	if len(missing) > 0 {
+		return sendBadRequest(c, "resource flavors not found: "+strings.Join(missing, ", "))
+	}
+
+	if missing, err = h.missingAdmissionChecks(cq.AdmissionChecks); err != nil {
+		log.Printf("failed checking cluster queue admission checks: %v", err)
+		return sendClientError(c, "Admission check not found", err)
+	}
+	if len(missing) > 0 {
+		return sendBadRequest(c, "admission checks not found: "+strings.Join(missing, ", "))
+	}
+
+	var updated *models.ClusterQueue
+	if h.EnvClient.IsMockMode() {
+		updated, err = h.MockClient.UpdateClusterQueue(*cq)
+	} else {
+		updated, err = h.K8sClient.UpdateClusterQueue(*cq)
+	}
+	if err != nil {
+		log.Printf("failed updating cluster queue: %v", err)
+		return sendClientError(c, "Cluster queue not found", err)
+	}
+
+	return c.JSON(updated)
+}
+
+// DeleteClusterQueue deletes a cluster queue
+// @Description Delete a cluster queue
+// @Summary Delete cluster queue
+// @Tags ClusterQueues
+// @Produce json
+// @Param name path string true "ClusterQueue name"
+// @Success 204
+// @Failure 404 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/cluster-queues/{name} [delete]
+func (h Handlers) DeleteClusterQueue(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var err error
+	if h.EnvClient.IsMockMode() {
+		err = h.MockClient.DeleteClusterQueue(name)
+	} else {
+		err = h.K8sClient.DeleteClusterQueue(name)
+	}
+	if err != nil {
+		log.Printf("failed deleting cluster queue: %v", err)
+		return sendClientError(c, "Cluster queue not found", err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// missingResourceFlavors returns the ResourceFlavors referenced by the resource groups that do not exist
+func (h Handlers) missingResourceFlavors(groups []models.ResourceGroup) ([]string, error) {
+	var flavors []models.ResourceFlavor
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		flavors, err = h.MockClient.ListResourceFlavors()
+	} else {
+		flavors, err = h.K8sClient.ListResourceFlavors()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed reading resource flavors: %w", err)
+	}
+
+	existing := make(map[string]bool, len(flavors))
+	for _, rf := range flavors {
+		existing[rf.Name] = true
+	}
+
+	var missing []string
+	for _, rg := range groups {
+		for _, f := range rg.Flavors {
+			if !existing[f.Name] && !slices.Contains(missing, f.Name) {
+				missing = append(missing, f.Name)
+			}
+		}
+	}
+	return missing, nil
+}
+
+// validateClusterQueueSchema validates the cluster queue creation and update requests
+func validateClusterQueueSchema(rawBody map[string]any) (*models.ClusterQueue, error) {
+	err := checkAllowedFields(rawBody, "admissionChecks", "cohort", "name", "namespaceSelector", "preemption", "queueingStrategy", "resourceGroups", "stopPolicy")
+	if err != nil {
+		return nil, err
+	}
+
+	cq := &models.ClusterQueue{}
+
+	if cq.Name, err = requiredString(rawBody, "name"); err != nil {
+		return nil, err
+	}
+
+	if cq.Cohort, err = optionalString(rawBody, "cohort"); err != nil {
+		return nil, err
+	}
+
+	if cq.QueueingStrategy, err = optionalEnum(rawBody, "queueingStrategy", "BestEffortFIFO", "StrictFIFO"); err != nil {
+		return nil, err
+	}
+
+	if cq.StopPolicy, err = optionalEnum(rawBody, "stopPolicy", "None", "Hold", "HoldAndDrain"); err != nil {
+		return nil, err
+	}
+
+	if cq.NamespaceSelector, err = validateLabelSelectorSchema(rawBody, "namespaceSelector"); err != nil {
+		return nil, err
+	}
+
+	if cq.Preemption, err = validatePreemptionSchema(rawBody); err != nil {
+		return nil, err
+	}
+
+	if cq.ResourceGroups, err = validateResourceGroupsSchema(rawBody, cq.Cohort != ""); err != nil {
+		return nil, err
+	}
+
+	if cq.AdmissionChecks, err = validateClusterQueueAdmissionChecksSchema(rawBody, cq.ResourceGroups); err != nil {
+		return nil, err
+	}
+
+	return cq, nil
+}
+
+// validateLabelSelectorSchema validates a label selector field, nil is returned when it is absent
+func validateLabelSelectorSchema(rawBody map[string]any, field string) (*models.LabelSelector, error) {
+	obj, err := optionalObject(rawBody, field)
+	if err != nil || obj == nil {
+		return nil, err
+	}
+
+	if err := checkAllowedFields(obj, "matchExpressions", "matchLabels"); err != nil {
+		return nil, fmt.Errorf("%s: %w", field, err)
+	}
+
+	selector := &models.LabelSelector{}
+	if selector.MatchLabels, err = optionalStringMap(obj, "matchLabels"); err != nil {
+		return nil, fmt.Errorf("%s: %w", field, err)
+	}
+
+	expressions, err := optionalObjectSlice(obj, "matchExpressions")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", field, err)
+	}
+	for _, e := range expressions {
+		var req models.LabelSelectorRequirement
+		if req.Key, err = requiredString(e, "key"); err != nil {
+			return nil, fmt.Errorf("%s: matchExpressions: %w", field, err)
+		}
+		if req.Operator, err = requiredString(e, "operator"); err != nil {
+			return nil, fmt.Errorf("%s: matchExpressions: %w", field, err)
+		}
+		if req.Values, err = optionalStringSlice(e, "values"); err != nil {
+			return nil, fmt.Errorf("%s: matchExpressions: %w", field, err)
+		}
+
+		switch req.Operator {
+		case "In", "NotIn":
+			if len(req.Values) == 0 {
+				return nil, fmt.Errorf("%s: operator %s on '%s' requires values", field, req.Operator, req.Key)
+			}
+		case "Exists", "DoesNotExist":
+			if len(req.Values) > 0 {
+				return nil, fmt.Errorf("%s: operator %s on '%s' does not take values", field, req.Operator, req.Key)
+			}
+		default:
+			return nil, fmt.Errorf("%s: operator must be one of In, NotIn, Exists, DoesNotExist", field)
+		}
+		selector.MatchExpressions = append(selector.MatchExpressions, req)
+	}
+
+	return selector, nil
+}
+
+func validatePreemptionSchema(rawBody map[string]any) (*models.ClusterQueuePreemption, error) {
+	obj, err := optionalObject(rawBody, "preemption")
+	if err != nil || obj == nil {
+		return nil, err
+	}
+
+	if err := checkAllowedFields(obj, "borrowWithinCohort", "reclaimWithinCohort", "withinClusterQueue"); err != nil {
+		return nil, fmt.Errorf("preemption: %w", err)
+	}
+
+	p := &models.ClusterQueuePreemption{}
+	if p.ReclaimWithinCohort, err = optionalEnum(obj, "reclaimWithinCohort", "Never", "LowerPriority", "Any"); err != nil {
+		return nil, fmt.Errorf("preemption: %w", err)
+	}
+	if p.WithinClusterQueue, err = optionalEnum(obj, "withinClusterQueue", "Never", "LowerPriority", "LowerOrNewerEqualPriority"); err != nil {
+		return nil, fmt.Errorf("preemption: %w", err)
+	}
+
+	borrow, err := optionalObject(obj, "borrowWithinCohort")
+	if err != nil {
+		return nil, fmt.Errorf("preemption: %w", err)
+	}
+	if borrow != nil {
+		if err := checkAllowedFields(borrow, "maxPriorityThreshold", "policy"); err != nil {
+			return nil, fmt.Errorf("preemption: borrowWithinCohort: %w", err)
+		}
+		p.BorrowWithinCohort = &models.BorrowWithinCohort{}
+		if p.BorrowWithinCohort.Policy, err = optionalEnum(borrow, "policy", "Never", "LowerPriority"); err != nil {
+			return nil, fmt.Errorf("preemption: borrowWithinCohort: %w", err)
+		}
+		if p.BorrowWithinCohort.MaxPriorityThreshold, err = optionalInt32(borrow, "maxPriorityThreshold"); err != nil {
+			return nil, fmt.Errorf("preemption: borrowWithinCohort: %w", err)
+		}
+	}
+
+	return p, nil
+}
+
+// validateResourceGroupsSchema validates the resource groups, borrowing and lending limits are only allowed in a cohort
+//
+//revive:disable:cyclomatic
+func validateResourceGroupsSchema(rawBody map[string]any, inCohort bool) ([]models.ResourceGroup, error) {
+	groups, err := optionalObjectSlice(rawBody, "resourceGroups")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []models.ResourceGroup
+	covered := make(map[string]bool)
+	seenFlavors := make(map[string]bool)
+
+	for _, g := range groups {
+		if err := checkAllowedFields(g, "coveredResources", "flavors"); err != nil {
+			return nil, fmt.Errorf("resourceGroups: %w", err)
+		}
+
+		rg := models.ResourceGroup{}
+		if rg.CoveredResources, err = optionalStringSlice(g, "coveredResources"); err != nil {
+			return nil, fmt.Errorf("resourceGroups: %w", err)
+		}
+		if len(rg.CoveredResources) == 0 {
+			return nil, fmt.Errorf("resourceGroups: field 'coveredResources' cannot be empty")
+		}
+		for _, r := range rg.CoveredResources {
+			if covered[r] {
+				return nil, fmt.Errorf("resourceGroups: resource '%s' is covered by more than one group", r)
+			}
+			covered[r] = true
+		}
+
+		flavors, err := optionalObjectSlice(g, "flavors")
+		if err != nil {
+			return nil, fmt.Errorf("resourceGroups: %w", err)
+		}
+		if len(flavors) == 0 {
+			return nil, fmt.Errorf("resourceGroups: field 'flavors' cannot be empty")
+		}
+
+		for _, f := range flavors {
+			if err := checkAllowedFields(f, "name", "resources"); err != nil {
+				return nil, fmt.Errorf("resourceGroups: flavors: %w", err)
+			}
+
+			fq := models.FlavorQuotas{}
+			if fq.Name, err = requiredString(f, "name"); err != nil {
+				return nil, fmt.Errorf("resourceGroups: flavors: %w", err)
+			}
+			if seenFlavors[fq.Name] {
+				return nil, fmt.Errorf("resourceGroups: flavor '%s' is used by more than one group", fq.Name)
+			}
+			seenFlavors[fq.Name] = true
+
+			resources, err := optionalObjectSlice(f, "resources")
+			if err != nil {
+				return nil, fmt.Errorf("resourceGroups: flavor '%s': %w", fq.Name, err)
+			}
+
+			// Kueue requires each flavor to list the covered resources in the same order
+			if len(resources) != len(rg.CoveredResources) {
+				return nil, fmt.Errorf("resourceGroups: flavor '%s' must set a quota for each of %s", fq.Name, strings.Join(rg.CoveredResources, ", "))
+			}
+
+			for i, r := range resources {
+				rq, err := validateResourceQuotaSchema(r, inCohort)
+				if err != nil {
+					return nil, fmt.Errorf("resourceGroups: flavor '%s': %w", fq.Name, err)
+				}
+				if rq.Name != rg.CoveredResources[i] {
+					return nil, fmt.Errorf("resourceGroups: flavor '%s' must list its resources in the order %s", fq.Name, strings.Join(rg.CoveredResources, ", "))
+				}
+				fq.Resources = append(fq.Resources, *rq)
+			}
+
+			rg.Flavors = append(rg.Flavors, fq)
+		}
+
+		result = append(result, rg)
+	}
+
+	return result, nil
+}
+
+//revive:enable:cyclomatic
+
+func validateResourceQuotaSchema(rawBody map[string]any, inCohort bool) (*models.ResourceQuota, error) {
+	err := checkAllowedFields(rawBody, "borrowingLimit", "lendingLimit", "name", "nominalQuota")
+	if err != nil {
+		return nil, err
+	}
+
+	rq := &models.ResourceQuota{}
+	if rq.Name, err = requiredString(rawBody, "name"); err != nil {
+		return nil, err
+	}
+
+	if _, exists := rawBody["nominalQuota"]; !exists {
+		return nil, fmt.Errorf("field 'nominalQuota' is required for %s", rq.Name)
+	}
+	if rq.NominalQuota, err = optionalQuantity(rawBody, "nominalQuota"); err != nil {
+		return nil, err
+	}
+	if rq.BorrowingLimit, err = optionalQuantity(rawBody, "borrowingLimit"); err != nil {
+		return nil, err
+	}
+	if rq.LendingLimit, err = optionalQuantity(rawBody, "lendingLimit"); err != nil {
+		return nil, err
+	}
+
+	for _, q := range []string{rq.NominalQuota, rq.BorrowingLimit, rq.LendingLimit} {
+		if q == "" {
+			continue
+		}
+		if parsed := resource.MustParse(q); parsed.Sign() < 0 {
+			return nil, fmt.Errorf("quotas for %s cannot be negative", rq.Name)
+		}
+	}
+
+	if !inCohort && (rq.BorrowingLimit != "" || rq.LendingLimit != "") {
+		return nil, fmt.Errorf("borrowingLimit and lendingLimit for %s require a cohort", rq.Name)
+	}
+
+	if rq.LendingLimit != "" {
+		lending := resource.MustParse(rq.LendingLimit)
+		if lending.Cmp(resource.MustParse(rq.NominalQuota)) > 0 {
+			return nil, fmt.Errorf("lendingLimit for %s cannot exceed its nominalQuota", rq.Name)
+		}
+	}
+
+	return rq, nil
+}
@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ReadCohorts returns cohorts as JSON
+// @Description Get cohorts
+// @Summary Get cohorts
+// @Tags Cohorts
+// @Produce json
+// @Success 200 {array} models.Cohort
+// @Success 204
+// @Router /api/v1/cohorts [get]
+func (h Handlers) ReadCohorts(c *fiber.Ctx) error {
+	var cohorts []models.Cohort
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		cohorts, err = h.MockClient.ListCohorts()
+	} else {
+		cohorts, err = h.K8sClient.ListCohorts()
+	}
+	if err != nil {
+		log.Printf("failed reading cohorts: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading cohorts"})
+	}
+	if len(cohorts) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+	return c.JSON(cohorts)
+}
+
+// ReadCohortDetail returns cohort detail as JSON
+// @Description Get cohort detail
+// @Summary Get cohort detail
+// @Tags Cohorts
+// @Produce json
+// @Param name path string true "Cohort name"
+// @Success 200 {object} models.Cohort
+// @Failure 404 {object} models.Error
+// @Router /api/v1/cohorts/{name} [get]
+func (h Handlers) ReadCohortDetail(c *fiber.Ctx) error {
+	var cohort *models.Cohort
+	var err error
+
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		cohort, err = h.MockClient.GetCohort(name)
+	} else {
+		cohort, err = h.K8sClient.GetCohort(name)
+	}
+	if err != nil {
+		log.Printf("failed reading cohort: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Cohort not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading cohort"})
+	}
+	return c.JSON(cohort)
+}
+
+// ReadCohortTree returns the cohort hierarchy as JSON
+// @Description Get the Cohort, ClusterQueue and LocalQueue hierarchy with quotas and usage summed at every level.
+// @Description Cohorts that are only referenced by a ClusterQueue or a child cohort are returned as implicit.
+// @Summary Get cohort tree
+// @Tags Cohorts
+// @Produce json
+// @Success 200 {object} models.CohortTree
+// @Router /api/v1/cohorts/tree [get]
+func (h Handlers) ReadCohortTree(c *fiber.Ctx) error {
+	var cohorts []models.Cohort
+	var clusterQueues []models.ClusterQueue
+	var localQueues []models.LocalQueue
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		if cohorts, err = h.MockClient.ListCohorts(); err == nil {
+			if clusterQueues, err = h.MockClient.ListClusterQueues(); err == nil {
+				localQueues, err = h.MockClient.ListLocalQueues()
+			}
+		}
+	} else {
+		if cohorts, err = h.K8sClient.ListCohorts(); err == nil {
+			if clusterQueues, err = h.K8sClient.ListClusterQueues(); err == nil {
+				localQueues, err = h.K8sClient.ListLocalQueues()
+			}
+		}
+	}
+	if err != nil {
+		log.Printf("failed reading cohort tree: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading cohort tree"})
+	}
+
+	return c.JSON(buildCohortTree(cohorts, clusterQueues, localQueues))
+}
+
+// CreateCohort creates a new cohort
+// @Description Create a new cohort. Every ResourceFlavor referenced by its resource groups must exist.
+// @Summary Create cohort
+// @Tags Cohorts
+// @Accept json
+// @Produce json
+// @Param cohort body models.Cohort true "Cohort to create"
+// @Success 201 {object} models.Cohort
+// @Failure 400 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/cohorts [post]
+func (h Handlers) CreateCohort(c *fiber.Ctx) error {
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	cohort, err := validateCohortSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	missing, err := h.missingResourceFlavors(cohort.ResourceGroups)
+	if err != nil {
+		log.Printf("failed checking cohort flavors: %v", err)
+		return sendClientError(c, "Resource flavor not found", err)
+	}
+	if len(missing) > 0 {
+		return sendBadRequest(c, "resource flavors not found: "+strings.Join(missing, ", "))
+	}
+
+	var created *models.Cohort
+	if h.EnvClient.IsMockMode() {
+		created, err = h.MockClient.CreateCohort(*cohort)
+	} else {
+		created, err = h.K8sClient.CreateCohort(*cohort)
+	}
+	if err != nil {
+		log.Printf("failed creating cohort: %v", err)
+		return sendClientError(c, "Cohort not found", err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(created)
+}
+
+// UpdateCohort replaces the spec of a cohort
+// @Description Replace the parent and resource groups of a cohort. Every ResourceFlavor referenced by its resource groups must exist.
+// @Description When resourceVersion is given and the cohort has been modified since, 409 is returned.
+// @Summary Update cohort
+// @Tags Cohorts
+// @Accept json
+// @Produce json
+// @Param name path string true "Cohort name"
+// @Param cohort body models.Cohort true "Cohort spec"
+// @Success 200 {object} models.Cohort
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/cohorts/{name} [put]
+func (h Handlers) UpdateCohort(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	if _, exists := rawBody["name"]; !exists {
+		rawBody["name"] = name
+	}
+
+	resourceVersion, err := optionalString(rawBody, "resourceVersion")
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+	delete(rawBody, "resourceVersion")
+
+	cohort, err := validateCohortSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+	if cohort.Name != name {
+		return sendBadRequest(c, "field 'name' cannot be changed")
+	}
+	cohort.ResourceVersion = resourceVersion
+
+	missing, err := h.missingResourceFlavors(cohort.ResourceGroups)
+	if err != nil {
+		log.Printf("failed checking cohort flavors: %v", err)
+		return sendClientError(c, "Resource flavor not found", err)
+	}
+	if len(missing) > 0 {
+		return sendBadRequest(c, "resource flavors not found: "+strings.Join(missing, ", "))
+	}
+
+	var updated *models.Cohort
+	if h.EnvClient.IsMockMode() {
+		updated, err = h.MockClient.UpdateCohort(*cohort)
+	} else {
+		updated, err = h.K8sClient.UpdateCohort(*cohort)
+	}
+	if err != nil {
+		log.Printf("failed updating cohort: %v", err)
+		return sendClientError(c, "Cohort not found", err)
+	}
+
+	return c.JSON(updated)
+}
+
+// DeleteCohort deletes a cohort
+// @Description Delete a cohort
+// @Summary Delete cohort
+// @Tags Cohorts
+// @Produce json
+// @Param name path string true "Cohort name"
+// @Success 204
+// @Failure 404 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/cohorts/{name} [delete]
+func (h Handlers) DeleteCohort(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var err error
+	if h.EnvClient.IsMockMode() {
+		err = h.MockClient.DeleteCohort(name)
+	} else {
+		err = h.K8sClient.DeleteCohort(name)
+	}
+	if err != nil {
+		log.Printf("failed deleting cohort: %v", err)
+		return sendClientError(c, "Cohort not found", err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// validateCohortSchema validates the cohort creation and update requests
+func validateCohortSchema(rawBody map[string]any) (*models.Cohort, error) {
+	err := checkAllowedFields(rawBody, "name", "parent", "resourceGroups")
+	if err != nil {
+		return nil, err
+	}
+
+	cohort := &models.Cohort{}
+
+	if cohort.Name, err = requiredString(rawBody, "name"); err != nil {
+		return nil, err
+	}
+
+	if cohort.Parent, err = optionalString(rawBody, "parent"); err != nil {
+		return nil, err
+	}
+	if cohort.Parent == cohort.Name {
+		return nil, fmt.Errorf("cohort '%s' cannot be its own parent", cohort.Name)
+	}
+
+	// Only a cohort with a parent has anything to borrow from or lend to
+	if cohort.ResourceGroups, err = validateResourceGroupsSchema(rawBody, cohort.Parent != ""); err != nil {
+		return nil, err
+	}
+
+	return cohort, nil
+}
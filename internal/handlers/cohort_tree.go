@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// resourceTotal is the running quota and usage of a resource in a flavor
+type resourceTotal struct {
+	borrowed resource.Quantity
+	flavor   string
+	nominal  resource.Quantity
+	quota    bool
+	resource string
+	usage    resource.Quantity
+}
+
+// resourceTotals sums quotas and usage per flavor and resource
+type resourceTotals map[[2]string]*resourceTotal
+
+func (t resourceTotals) get(flavor, name string) *resourceTotal {
+	key := [2]string{flavor, name}
+	if t[key] == nil {
+		t[key] = &resourceTotal{flavor: flavor, resource: name}
+	}
+	return t[key]
+}
+
+func (t resourceTotals) addQuotas(groups []models.ResourceGroup) {
+	for _, rg := range groups {
+		for _, f := range rg.Flavors {
+			for _, r := range f.Resources {
+				q, err := resource.ParseQuantity(r.NominalQuota)
+				if err != nil {
+					continue
+				}
+				total := t.get(f.Name, r.Name)
+				total.nominal.Add(q)
+				total.quota = true
+			}
+		}
+	}
+}
+
+func (t resourceTotals) addUsage(usage []models.FlavorUsage) {
+	for _, f := range usage {
+		for _, r := range f.Resources {
+			total := t.get(f.Name, r.Name)
+			if q, err := resource.ParseQuantity(r.Total); err == nil {
+				total.usage.Add(q)
+			}
+			if q, err := resource.ParseQuantity(r.Borrowed); err == nil {
+				total.borrowed.Add(q)
+			}
+		}
+	}
+}
+
+func (t resourceTotals) addTotals(totals []models.ResourceTotal) {
+	for _, m := range totals {
+		total := t.get(m.Flavor, m.Resource)
+		if q, err := resource.ParseQuantity(m.NominalQuota); err == nil {
+			total.nominal.Add(q)
+			total.quota = true
+		}
+		if q, err := resource.ParseQuantity(m.Usage); err == nil {
+			total.usage.Add(q)
+		}
+	}
+}
+
+// toModels returns the totals ordered by flavor and resource. When derived is set, borrowing is
+// what the usage exceeds the quota by, otherwise it is the borrowing reported by Kueue.
+func (t resourceTotals) toModels(derived bool) []models.ResourceTotal {
+	var result []models.ResourceTotal
+	for _, total := range t {
+		m := models.ResourceTotal{
+			Flavor:   total.flavor,
+			Resource: total.resource,
+			Usage:    total.usage.String(),
+		}
+		if total.quota {
+			m.NominalQuota = total.nominal.String()
+		}
+
+		borrowed := total.borrowed.DeepCopy()
+		if derived {
+			borrowed = total.usage.DeepCopy()
+			borrowed.Sub(total.nominal)
+		}
+		if borrowed.Sign() > 0 {
+			m.Borrowed = borrowed.String()
+		}
+
+		result = append(result, m)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Flavor != result[j].Flavor {
+			return result[i].Flavor < result[j].Flavor
+		}
+		return result[i].Resource < result[j].Resource
+	})
+	return result
+}
+
+// buildCohortTree builds the Cohort, ClusterQueue and LocalQueue hierarchy with quotas and usage summed at every level.
+// Cohorts that are referenced but have no Cohort object are included as implicit, ClusterQueues without a cohort are returned at the top level.
+//
+//revive:disable:cyclomatic
+func buildCohortTree(cohorts []models.Cohort, clusterQueues []models.ClusterQueue, localQueues []models.LocalQueue) models.CohortTree {
+	byName := make(map[string]models.Cohort, len(cohorts))
+	for _, co := range cohorts {
+		byName[co.Name] = co
+	}
+
+	implicit := make(map[string]bool)
+	addImplicit := func(name string) {
+		if _, exists := byName[name]; !exists && name != "" {
+			byName[name] = models.Cohort{Name: name}
+			implicit[name] = true
+		}
+	}
+	for _, co := range cohorts {
+		addImplicit(co.Parent)
+	}
+	for _, cq := range clusterQueues {
+		addImplicit(cq.Cohort)
+	}
+
+	children := make(map[string][]string)
+	for name, co := range byName {
+		if co.Parent != "" {
+			children[co.Parent] = append(children[co.Parent], name)
+		}
+	}
+
+	lqsByCQ := make(map[string][]models.LocalQueue)
+	for _, lq := range localQueues {
+		lqsByCQ[lq.ClusterQueue] = append(lqsByCQ[lq.ClusterQueue], lq)
+	}
+
+	cqNode := func(cq models.ClusterQueue) models.ClusterQueueTreeNode {
+		node := models.ClusterQueueTreeNode{Name: cq.Name}
+		for _, lq := range lqsByCQ[cq.Name] {
+			totals := resourceTotals{}
+			totals.addUsage(lq.FlavorsUsage)
+			node.LocalQueues = append(node.LocalQueues, models.LocalQueueTreeNode{
+				Name:      lq.Name,
+				Namespace: lq.Namespace,
+				Resources: totals.toModels(false),
+			})
+		}
+		sort.Slice(node.LocalQueues, func(i, j int) bool {
+			if node.LocalQueues[i].Namespace != node.LocalQueues[j].Namespace {
+				return node.LocalQueues[i].Namespace < node.LocalQueues[j].Namespace
+			}
+			return node.LocalQueues[i].Name < node.LocalQueues[j].Name
+		})
+
+		totals := resourceTotals{}
+		totals.addQuotas(cq.ResourceGroups)
+		totals.addUsage(cq.FlavorsUsage)
+		node.Resources = totals.toModels(false)
+		return node
+	}
+
+	cqsByCohort := make(map[string][]models.ClusterQueue)
+	var tree models.CohortTree
+	for _, cq := range clusterQueues {
+		if cq.Cohort == "" {
+			tree.ClusterQueues = append(tree.ClusterQueues, cqNode(cq))
+			continue
+		}
+		cqsByCohort[cq.Cohort] = append(cqsByCohort[cq.Cohort], cq)
+	}
+
+	// visited guards against parent cycles, which Kueue reports but does not prevent
+	visited := make(map[string]bool)
+	var cohortNode func(name string) models.CohortTreeNode
+	cohortNode = func(name string) models.CohortTreeNode {
+		visited[name] = true
+		co := byName[name]
+		node := models.CohortTreeNode{
+			Implicit: implicit[name],
+			Name:     name,
+			Parent:   co.Parent,
+		}
+
+		totals := resourceTotals{}
+		totals.addQuotas(co.ResourceGroups)
+
+		for _, cq := range cqsByCohort[name] {
+			child := cqNode(cq)
+			totals.addTotals(child.Resources)
+			node.ClusterQueues = append(node.ClusterQueues, child)
+		}
+
+		for _, childName := range children[name] {
+			if visited[childName] {
+				continue
+			}
+			child := cohortNode(childName)
+			totals.addTotals(child.Resources)
+			node.ChildCohorts = append(node.ChildCohorts, child)
+		}
+
+		sort.Slice(node.ClusterQueues, func(i, j int) bool {
+			return node.ClusterQueues[i].Name < node.ClusterQueues[j].Name
+		})
+		sort.Slice(node.ChildCohorts, func(i, j int) bool {
+			return node.ChildCohorts[i].Name < node.ChildCohorts[j].Name
+		})
+
+		node.Resources = totals.toModels(true)
+		return node
+	}
+
+	var names []string
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if byName[name].Parent == "" {
+			tree.Cohorts = append(tree.Cohorts, cohortNode(name))
+		}
+	}
+	// Cohorts left over are part of a parent cycle, each cycle is shown from its first cohort
+	for _, name := range names {
+		if !visited[name] {
+			tree.Cohorts = append(tree.Cohorts, cohortNode(name))
+		}
+	}
+
+	sort.Slice(tree.ClusterQueues, func(i, j int) bool {
+		return tree.ClusterQueues[i].Name < tree.ClusterQueues[j].Name
+	})
+	return tree
+}
+
+//revive:enable:cyclomatic
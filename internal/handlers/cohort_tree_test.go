@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+	"reflect"
+	"testing"
+)
+
+func gpuQuota(quota string) []models.ResourceGroup {
+	return []models.ResourceGroup{{
+		CoveredResources: []string{"nvidia.com/gpu"},
+		Flavors:          []models.FlavorQuotas{{Name: "gpu", Resources: []models.ResourceQuota{{Name: "nvidia.com/gpu", NominalQuota: quota}}}},
+	}}
+}
+
+func gpuUsage(total string) []models.FlavorUsage {
+	return []models.FlavorUsage{{Name: "gpu", Resources: []models.ResourceUsage{{Name: "nvidia.com/gpu", Total: total}}}}
+}
+
+func gpuTotal(quota, usage, borrowed string) []models.ResourceTotal {
+	return []models.ResourceTotal{{Borrowed: borrowed, Flavor: "gpu", NominalQuota: quota, Resource: "nvidia.com/gpu", Usage: usage}}
+}
+
+func TestBuildCohortTree(t *testing.T) {
+	tests := []struct {
+		name          string
+		cohorts       []models.Cohort
+		clusterQueues []models.ClusterQueue
+		localQueues   []models.LocalQueue
+		want          models.CohortTree
+	}{
+		{
+			name:          "ClusterQueue without a cohort is at the top level",
+			clusterQueues: []models.ClusterQueue{{Name: "cq", ResourceGroups: gpuQuota("4"), FlavorsUsage: gpuUsage("1")}},
+			localQueues:   []models.LocalQueue{{Name: "lq", Namespace: "ns", ClusterQueue: "cq", FlavorsUsage: gpuUsage("1")}},
+			want: models.CohortTree{
+				ClusterQueues: []models.ClusterQueueTreeNode{{
+					LocalQueues: []models.LocalQueueTreeNode{{Name: "lq", Namespace: "ns", Resources: gpuTotal("", "1", "")}},
+					Name:        "cq",
+					Resources:   gpuTotal("4", "1", ""),
+				}},
+			},
+		},
+		{
+			name:          "cohort without a Cohort object is implicit",
+			clusterQueues: []models.ClusterQueue{{Name: "cq", Cohort: "team", ResourceGroups: gpuQuota("4"), FlavorsUsage: gpuUsage("1")}},
+			want: models.CohortTree{
+				Cohorts: []models.CohortTreeNode{{
+					ClusterQueues: []models.ClusterQueueTreeNode{{Name: "cq", Resources: gpuTotal("4", "1", "")}},
+					Implicit:      true,
+					Name:          "team",
+					Resources:     gpuTotal("4", "1", ""),
+				}},
+			},
+		},
+		{
+			name:    "quotas and usage are summed up the tree and borrowing is the usage over the quota",
+			cohorts: []models.Cohort{{Name: "org", ResourceGroups: gpuQuota("2")}, {Name: "team", Parent: "org"}},
+			clusterQueues: []models.ClusterQueue{
+				{Name: "a", Cohort: "team", ResourceGroups: gpuQuota("2"), FlavorsUsage: gpuUsage("3")},
+				{Name: "b", Cohort: "team", ResourceGroups: gpuQuota("2"), FlavorsUsage: gpuUsage("2")},
+			},
+			want: models.CohortTree{
+				Cohorts: []models.CohortTreeNode{{
+					ChildCohorts: []models.CohortTreeNode{{
+						ClusterQueues: []models.ClusterQueueTreeNode{
+							{Name: "a", Resources: gpuTotal("2", "3", "")},
+							{Name: "b", Resources: gpuTotal("2", "2", "")},
+						},
+						Name:      "team",
+						Parent:    "org",
+						Resources: gpuTotal("4", "5", "1"),
+					}},
+					Name:      "org",
+					Resources: gpuTotal("6", "5", ""),
+				}},
+			},
+		},
+		{
+			name:    "cohorts in a parent cycle are shown once",
+			cohorts: []models.Cohort{{Name: "x", Parent: "y"}, {Name: "y", Parent: "x"}},
+			want: models.CohortTree{
+				Cohorts: []models.CohortTreeNode{{
+					ChildCohorts: []models.CohortTreeNode{{Name: "y", Parent: "x"}},
+					Name:         "x",
+					Parent:       "y",
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildCohortTree(tt.cohorts, tt.clusterQueues, tt.localQueues); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildCohortTree() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
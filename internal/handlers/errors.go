@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// sendClientError maps an error returned by a mutating k8s or mock client call to a response.
+// Rejected requests and conflicting edits keep their status, anything else is reported as a bad gateway.
+func sendClientError(c *fiber.Ctx, notFound string, err error) error {
+	if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+		return c.Status(404).JSON(fiber.Map{"error": notFound})
+	}
+
+	status := fiber.StatusBadGateway
+	var fe *fiber.Error
+	switch {
+	case apierrors.IsBadRequest(err), apierrors.IsInvalid(err):
+		status = fiber.StatusBadRequest
+	case apierrors.IsConflict(err), apierrors.IsAlreadyExists(err):
+		status = fiber.StatusConflict
+	case errors.As(err, &fe):
+		status = fe.Code
+	}
+
+	return c.Status(status).JSON(models.Error{
+		Code:    status,
+		Message: utils.StatusMessage(status),
+		Reason:  err.Error(),
+	})
+}
+
+// sendBadRequest responds with a 400 and the reason the request was rejected
+func sendBadRequest(c *fiber.Ctx, reason string) error {
+	return c.Status(fiber.StatusBadRequest).JSON(models.Error{
+		Code:    fiber.StatusBadRequest,
+		Message: utils.StatusMessage(fiber.StatusBadRequest),
+		Reason:  reason,
+	})
+}
+
+// sendConflict responds with a 409 and the reason the request conflicts with the current state
+func sendConflict(c *fiber.Ctx, reason string) error {
+	return c.Status(fiber.StatusConflict).JSON(models.Error{
+		Code:    fiber.StatusConflict,
+		Message: utils.StatusMessage(fiber.StatusConflict),
+		Reason:  reason,
+	})
+}
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// GPU feature discovery labels
+const (
+	gpuCountLabel       = "nvidia.com/gpu.count"
+	gpuMemoryLabel      = "nvidia.com/gpu.memory"
+	gpuMigCapableLabel  = "nvidia.com/mig.capable"
+	gpuMigStrategyLabel = "nvidia.com/mig.strategy"
+)
+
+// gpuProductLabels are the labels naming the GPU model of a node, in order of preference
+var gpuProductLabels = []string{"nvidia.com/gpu.product", "amd.com/gpu.product-name", "gpu.intel.com/product"}
+
+// ReadGpus returns the GPU inventory of the cluster as JSON
+// @Description Get the GPUs of every node and grouped by GPU model, with the capacity, allocatable and allocated devices per extended resource
+// @Description (such as nvidia.com/gpu and MIG devices like nvidia.com/mig-3g.20gb) and the totals across the cluster. The model, memory and MIG
+// @Description configuration come from the GPU feature discovery labels, allocated devices are those requested by non-terminated pods.
+// @Summary Get GPU inventory
+// @Tags Gpus
+// @Produce json
+// @Success 200 {object} models.GpuInventory
+// @Success 204
+// @Router /api/v1/gpus [get]
+func (h Handlers) ReadGpus(c *fiber.Ctx) error {
+	var nodes []models.Node
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		nodes, err = h.MockClient.ListNodes()
+	} else {
+		nodes, err = h.K8sClient.ListNodes()
+	}
+	if err != nil {
+		log.Printf("failed reading nodes: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading nodes"})
+	}
+
+	pods := h.listPodsByNode()
+	if pods == nil {
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading pods"})
+	}
+
+	inventory := buildGpuInventory(nodes, pods)
+	if len(inventory.Nodes) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+	return c.JSON(inventory)
+}
+
+// buildGpuInventory lists the nodes with GPU resources and sums their devices per GPU model and across the cluster
+func buildGpuInventory(nodes []models.Node, pods map[string][]models.Pod) models.GpuInventory {
+	inventory := models.GpuInventory{
+		Models:    []models.GpuModel{},
+		Nodes:     []models.GpuNode{},
+		Resources: make(map[string]models.GpuResource),
+	}
+	byProduct := make(map[string]*models.GpuModel)
+
+	for _, n := range nodes {
+		resources := nodeGpuResources(n, pods[n.Name])
+		if len(resources) == 0 {
+			continue
+		}
+
+		node := models.GpuNode{
+			MigCapable:  n.Labels[gpuMigCapableLabel] == "true",
+			MigStrategy: n.Labels[gpuMigStrategyLabel],
+			Name:        n.Name,
+			Product:     "unknown",
+			Ready:       n.Ready,
+			Resources:   resources,
+		}
+		for _, label := range gpuProductLabels {
+			if product := n.Labels[label]; product != "" {
+				node.Product = product
+				break
+			}
+		}
+		if count, err := strconv.Atoi(n.Labels[gpuCountLabel]); err == nil {
+			node.Count = count
+		}
+		// GPU feature discovery reports the memory of one GPU in MiB
+		if mib, err := strconv.ParseInt(n.Labels[gpuMemoryLabel], 10, 64); err == nil {
+			node.Memory = resource.NewQuantity(mib*1024*1024, resource.BinarySI).String()
+		}
+		inventory.Nodes = append(inventory.Nodes, node)
+
+		model, exists := byProduct[node.Product]
+		if !exists {
+			model = &models.GpuModel{Memory: node.Memory, Product: node.Product, Resources: make(map[string]models.GpuResource)}
+			byProduct[node.Product] = model
+		}
+		model.Nodes = append(model.Nodes, node.Name)
+		addGpuResources(model.Resources, resources)
+		addGpuResources(inventory.Resources, resources)
+	}
+
+	sort.Slice(inventory.Nodes, func(i, j int) bool { return inventory.Nodes[i].Name < inventory.Nodes[j].Name })
+	for _, model := range byProduct {
+		sort.Strings(model.Nodes)
+		inventory.Models = append(inventory.Models, *model)
+	}
+	sort.Slice(inventory.Models, func(i, j int) bool { return inventory.Models[i].Product < inventory.Models[j].Product })
+	return inventory
+}
+
+// nodeGpuResources returns the GPU resources of a node with the devices requested by its non-terminated pods
+func nodeGpuResources(n models.Node, pods []models.Pod) map[string]models.GpuResource {
+	result := make(map[string]models.GpuResource)
+	for name, value := range n.Capacity {
+		if !isGpuInventoryResource(name) {
+			continue
+		}
+		capacity := parseStatusQuantity(value)
+		if capacity.IsZero() {
+			continue
+		}
+		allocatable := parseStatusQuantity(n.Allocatable[name])
+		result[name] = models.GpuResource{Allocatable: allocatable.Value(), Capacity: capacity.Value()}
+	}
+
+	for _, p := range pods {
+		if p.Status == "Succeeded" || p.Status == "Failed" {
+			continue
+		}
+		for name, value := range p.Requests {
+			gpu, exists := result[name]
+			if !exists {
+				continue
+			}
+			gpu.Allocated += parseStatusQuantity(value).Value()
+			result[name] = gpu
+		}
+	}
+
+	for name, gpu := range result {
+		gpu.Available = max(gpu.Allocatable-gpu.Allocated, 0)
+		result[name] = gpu
+	}
+	return result
+}
+
+func addGpuResources(totals, resources map[string]models.GpuResource) {
+	for name, gpu := range resources {
+		total := totals[name]
+		total.Allocatable += gpu.Allocatable
+		total.Allocated += gpu.Allocated
+		total.Available += gpu.Available
+		total.Capacity += gpu.Capacity
+		totals[name] = total
+	}
+}
+
+// isGpuInventoryResource reports whether an extended resource is a GPU or a MIG device of an NVIDIA GPU
+func isGpuInventoryResource(name string) bool {
+	return isGPUResource(name) || strings.HasPrefix(name, "nvidia.com/mig-")
+}
@@ -17,10 +17,13 @@ type Handlers struct {
 	EnvClient  *env.Client
 	K8sClient  *k8s.Client
 	MockClient *mock.Client
+
+	drains  *nodeDrains
+	metrics *metricsAvailability
 }
 
 func NewHandlers(app *fiber.App, envClient *env.Client, k8sClient *k8s.Client, mockClient *mock.Client) Handlers {
-	handlers := Handlers{App: app, EnvClient: envClient, K8sClient: k8sClient, MockClient: mockClient}
+	handlers := Handlers{App: app, EnvClient: envClient, K8sClient: k8sClient, MockClient: mockClient, drains: newNodeDrains(), metrics: &metricsAvailability{}}
 
 	// Middleware
 	handlers.App.Use(recover.New())
@@ -36,26 +39,112 @@ func NewHandlers(app *fiber.App, envClient *env.Client, k8sClient *k8s.Client, m
 
 	v1 := handlers.App.Group("/api/v1")
 	v1.Get("/nodes", handlers.ReadNodes)
+	v1.Patch("/nodes/labels", handlers.UpdateNodesLabels)
+	v1.Patch("/nodes/taints", handlers.UpdateNodesTaints)
 	v1.Get("/nodes/:name", handlers.ReadNodeDetail)
+	v1.Post("/nodes/:name/cordon", handlers.CordonNode)
+	v1.Post("/nodes/:name/uncordon", handlers.UncordonNode)
+	v1.Post("/nodes/:name/drain", handlers.DrainNode)
+	v1.Get("/nodes/:name/drain", handlers.ReadNodeDrain)
+	v1.Patch("/nodes/:name/labels", handlers.UpdateNodeLabels)
+	v1.Patch("/nodes/:name/taints", handlers.UpdateNodeTaints)
+
+	v1.Get("/gpus", handlers.ReadGpus)
+	v1.Get("/top", handlers.ReadTop)
 
 	v1.Get("/pods", handlers.ReadPods)
 	v1.Get("/namespaces/:namespace/pods/:name", handlers.ReadPodDetail)
+	v1.Get("/namespaces/:namespace/pods/:name/logs", handlers.ReadPodLogs)
 
+	v1.Get("/jobs", handlers.ReadJobs)
 	v1.Post("/jobs", handlers.CreateJob)
+	v1.Get("/namespaces/:namespace/jobs/:name", handlers.ReadJobDetail)
+	v1.Delete("/namespaces/:namespace/jobs/:name", handlers.DeleteJob)
+	v1.Get("/namespaces/:namespace/jobs/:name/logs", handlers.ReadJobLogs)
+	v1.Post("/namespaces/:namespace/jobs/:name/suspend", handlers.SuspendJob)
+	v1.Post("/namespaces/:namespace/jobs/:name/resume", handlers.ResumeJob)
+	v1.Post("/namespaces/:namespace/jobs/:name/requeue", handlers.RequeueJob)
+
+	v1.Get("/workloads", handlers.ReadWorkloads)
+	v1.Get("/namespaces/:namespace/workloads/:name", handlers.ReadWorkloadDetail)
+
+	v1.Get("/workload-priority-classes", handlers.ReadWorkloadPriorityClasses)
+	v1.Get("/workload-priority-classes/:name", handlers.ReadWorkloadPriorityClassDetail)
+	v1.Post("/workload-priority-classes", handlers.CreateWorkloadPriorityClass)
+	v1.Put("/workload-priority-classes/:name", handlers.UpdateWorkloadPriorityClass)
+	v1.Delete("/workload-priority-classes/:name", handlers.DeleteWorkloadPriorityClass)
 
 	v1.Get("/resource-flavors", handlers.ReadResourceFlavors)
 	v1.Get("/resource-flavors/:name", handlers.ReadResourceFlavorDetail)
 	v1.Post("/resource-flavors", handlers.CreateResourceFlavor)
+	v1.Put("/resource-flavors/:name", handlers.UpdateResourceFlavor)
+	v1.Patch("/resource-flavors/:name", handlers.PatchResourceFlavor)
+	v1.Get("/resource-flavors/:name/nodes", handlers.ReadResourceFlavorNodes)
 	v1.Delete("/resource-flavors/:name", handlers.DeleteResourceFlavor)
 
+	v1.Get("/topologies", handlers.ReadTopologies)
+	v1.Get("/topologies/:name", handlers.ReadTopologyDetail)
+	v1.Get("/topologies/:name/domains", handlers.ReadTopologyDomains)
+	v1.Post("/topologies", handlers.CreateTopology)
+	v1.Delete("/topologies/:name", handlers.DeleteTopology)
+
+	v1.Get("/cluster-queues", handlers.ReadClusterQueues)
+	v1.Get("/cluster-queues/:name", handlers.ReadClusterQueueDetail)
+	v1.Post("/cluster-queues", handlers.CreateClusterQueue)
+	v1.Put("/cluster-queues/:name", handlers.UpdateClusterQueue)
+	v1.Delete("/cluster-queues/:name", handlers.DeleteClusterQueue)
+	v1.Put("/cluster-queues/:name/admission-checks", handlers.UpdateClusterQueueAdmissionChecks)
+
+	v1.Get("/admission-checks", handlers.ReadAdmissionChecks)
+	v1.Get("/admission-checks/:name", handlers.ReadAdmissionCheckDetail)
+	v1.Post("/admission-checks", handlers.CreateAdmissionCheck)
+	v1.Delete("/admission-checks/:name", handlers.DeleteAdmissionCheck)
+
+	v1.Get("/provisioning-request-configs", handlers.ReadProvisioningRequestConfigs)
+	v1.Get("/provisioning-request-configs/:name", handlers.ReadProvisioningRequestConfigDetail)
+	v1.Post("/provisioning-request-configs", handlers.CreateProvisioningRequestConfig)
+	v1.Delete("/provisioning-request-configs/:name", handlers.DeleteProvisioningRequestConfig)
+
+	v1.Get("/multikueue-clusters", handlers.ReadMultiKueueClusters)
+	v1.Get("/multikueue-clusters/:name", handlers.ReadMultiKueueClusterDetail)
+	v1.Post("/multikueue-clusters", handlers.CreateMultiKueueCluster)
+	v1.Delete("/multikueue-clusters/:name", handlers.DeleteMultiKueueCluster)
+
+	v1.Get("/multikueue-configs", handlers.ReadMultiKueueConfigs)
+	v1.Get("/multikueue-configs/:name", handlers.ReadMultiKueueConfigDetail)
+	v1.Post("/multikueue-configs", handlers.CreateMultiKueueConfig)
+	v1.Put("/multikueue-configs/:name", handlers.UpdateMultiKueueConfig)
+	v1.Delete("/multikueue-configs/:name", handlers.DeleteMultiKueueConfig)
+
+	v1.Get("/cohorts", handlers.ReadCohorts)
+	v1.Get("/cohorts/tree", handlers.ReadCohortTree)
+	v1.Get("/cohorts/:name", handlers.ReadCohortDetail)
+	v1.Post("/cohorts", handlers.CreateCohort)
+	v1.Put("/cohorts/:name", handlers.UpdateCohort)
+	v1.Delete("/cohorts/:name", handlers.DeleteCohort)
+
 	v1.Get("/local-queues", handlers.ReadLocalQueues)
 	v1.Get("/namespaces/:namespace/local-queues/:name", handlers.ReadLocalQueueDetail)
 	v1.Post("/namespaces/:namespace/local-queues", handlers.CreateLocalQueue)
+	v1.Put("/namespaces/:namespace/local-queues/:name", handlers.UpdateLocalQueue)
+	v1.Patch("/namespaces/:namespace/local-queues/:name", handlers.PatchLocalQueue)
 	v1.Delete("/namespaces/:namespace/local-queues/:name", handlers.DeleteLocalQueue)
 
 	v1.Get("/kai-scheduler-queues", handlers.ReadKaiSchedulerQueues)
+	v1.Get("/kai-scheduler-queues/tree", handlers.ReadKaiSchedulerQueueTree)
+	v1.Post("/kai-scheduler-queues", handlers.CreateKaiSchedulerQueue)
+	v1.Post("/kai-scheduler-queues/simulate", handlers.SimulateKaiSchedulerQueues)
+	v1.Get("/kai-scheduler-queues/:name", handlers.ReadKaiSchedulerQueueDetail)
+	v1.Put("/kai-scheduler-queues/:name", handlers.UpdateKaiSchedulerQueue)
+	v1.Delete("/kai-scheduler-queues/:name", handlers.DeleteKaiSchedulerQueue)
 	v1.Get("/kai-scheduler-queues/:name/child-queues", handlers.ReadKaiSchedulerChildQueues)
 
+	v1.Get("/pod-groups", handlers.ReadPodGroups)
+	v1.Get("/namespaces/:namespace/pod-groups/:name", handlers.ReadPodGroupDetail)
+
+	v1.Get("/bind-requests", handlers.ReadBindRequests)
+	v1.Get("/namespaces/:namespace/bind-requests/:name", handlers.ReadBindRequestDetail)
+
 	// Must come last
 	handlers.App.Use(NotFound)
 
@@ -3,18 +3,83 @@ package handlers
 import (
 	"cmyk/internal/models"
 
-	"context"
 	"fmt"
 	"log"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/utils"
-	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// ReadJobs returns jobs as JSON
+// @Description Get jobs, optionally filtered by namespace, Kueue LocalQueue and state
+// @Summary Get jobs
+// @Tags Job
+// @Produce json
+// @Param namespace query string false "Job namespace"
+// @Param queue query string false "Kueue LocalQueue or KAI Scheduler queue name"
+// @Param state query string false "Job state (Pending, Suspended, Running, Succeeded, Failed)"
+// @Success 200 {array} models.JobDetail
+// @Success 204
+// @Router /api/v1/jobs [get]
+func (h Handlers) ReadJobs(c *fiber.Ctx) error {
+	var jobs []models.JobDetail
+	var err error
+
+	namespace := c.Query("namespace")
+	queue := c.Query("queue")
+	state := c.Query("state")
+
+	if h.EnvClient.IsMockMode() {
+		jobs, err = h.MockClient.ListJobs(namespace, queue, state)
+	} else {
+		jobs, err = h.K8sClient.ListJobs(namespace, queue, state)
+	}
+	if err != nil {
+		log.Printf("failed reading jobs: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading jobs"})
+	}
+	if len(jobs) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+	return c.JSON(jobs)
+}
+
+// ReadJobDetail returns job detail as JSON
+// @Description Get job detail with status, Kueue admission state and owned pods
+// @Summary Get job detail
+// @Tags Job
+// @Produce json
+// @Param namespace path string true "Job namespace"
+// @Param name path string true "Job name"
+// @Success 200 {object} models.JobDetail
+// @Failure 404 {object} models.Error
+// @Router /api/v1/namespaces/{namespace}/jobs/{name} [get]
+func (h Handlers) ReadJobDetail(c *fiber.Ctx) error {
+	var job *models.JobDetail
+	var err error
+
+	namespace := c.Params("namespace")
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		job, err = h.MockClient.GetJob(namespace, name)
+	} else {
+		job, err = h.K8sClient.GetJob(namespace, name)
+	}
+	if err != nil {
+		log.Printf("failed reading job: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Job not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading job"})
+	}
+	return c.JSON(job)
+}
+
 // CreateJob func creates a new job
-// @Description Create a new job
+// @Description Create a new batch/v1 Job in a Kueue LocalQueue, or with scheduler "kai" in a KAI Scheduler leaf queue. Kueue jobs may set a workload priority class.
 // @Summary Create job
 // @Tags Job
 // @Accept json
@@ -22,93 +87,273 @@ import (
 // @Param job body models.Job true "Job to create"
 // @Success 201 {object} models.Job
 // @Failure 400 {object} models.Error
+// @Failure 502 {object} models.Error
 // @Router /api/v1/jobs [post]
 func (h Handlers) CreateJob(c *fiber.Ctx) error {
 	var rawBody map[string]any
 	if err := c.BodyParser(&rawBody); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(models.Error{
-			Code:    fiber.StatusBadRequest,
-			Message: utils.StatusMessage(fiber.StatusBadRequest),
-			Reason:  "Cannot parse JSON",
-		})
+		return sendBadRequest(c, "Cannot parse JSON")
 	}
 
 	job, err := validateJobSchema(rawBody)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(models.Error{
-			Code:    fiber.StatusBadRequest,
-			Message: utils.StatusMessage(fiber.StatusBadRequest),
-			Reason:  err.Error(),
-		})
+		return sendBadRequest(c, err.Error())
 	}
 
 	// TODO - Remove this check when the service is changed to not start if the K8s client cannot be initialized properly
 	if h.K8sClient != nil {
-		err = h.runJobInCluster(job.Name)
+		created, err := h.K8sClient.CreateJob(*job)
 		if err != nil {
-			log.Printf("failed running job in cluster: %v", err)
+			log.Printf("failed creating job: %v", err)
+			if apierrors.IsBadRequest(err) {
+				return sendBadRequest(c, err.Error())
+			}
 			return c.Status(fiber.StatusBadGateway).JSON(models.Error{
 				Code:    fiber.StatusBadGateway,
 				Message: utils.StatusMessage(fiber.StatusBadGateway),
 				Reason:  err.Error(),
 			})
 		}
+		return c.Status(fiber.StatusCreated).JSON(created)
 	}
 
 	return c.Status(fiber.StatusCreated).JSON(job)
 }
 
+// DeleteJob deletes a job
+// @Description Delete a job, its pods are removed according to the propagation policy
+// @Summary Delete job
+// @Tags Job
+// @Produce json
+// @Param namespace path string true "Job namespace"
+// @Param name path string true "Job name"
+// @Param propagationPolicy query string false "Background (default), Foreground or Orphan"
+// @Success 204
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/namespaces/{namespace}/jobs/{name} [delete]
+func (h Handlers) DeleteJob(c *fiber.Ctx) error {
+	namespace := c.Params("namespace")
+	name := c.Params("name")
+
+	propagationPolicy := c.Query("propagationPolicy", string(metav1.DeletePropagationBackground))
+	switch metav1.DeletionPropagation(propagationPolicy) {
+	case metav1.DeletePropagationBackground, metav1.DeletePropagationForeground, metav1.DeletePropagationOrphan:
+	default:
+		return sendBadRequest(c, "query 'propagationPolicy' must be one of Background, Foreground, Orphan")
+	}
+
+	var err error
+	if h.EnvClient.IsMockMode() {
+		err = h.MockClient.DeleteJob(namespace, name, propagationPolicy)
+	} else {
+		err = h.K8sClient.DeleteJob(namespace, name, propagationPolicy)
+	}
+	if err != nil {
+		log.Printf("failed deleting job: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Job not found"})
+		}
+		return c.Status(fiber.StatusBadGateway).JSON(models.Error{
+			Code:    fiber.StatusBadGateway,
+			Message: utils.StatusMessage(fiber.StatusBadGateway),
+			Reason:  err.Error(),
+		})
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// SuspendJob suspends a job
+// @Description Suspend a job by deactivating its Kueue Workload, which evicts it and releases its quota. Jobs not managed by Kueue are suspended directly.
+// @Description The Workload is returned as it is right after it is deactivated, poll the job detail for the conditions Kueue sets afterwards.
+// @Summary Suspend job
+// @Tags Job
+// @Produce json
+// @Param namespace path string true "Job namespace"
+// @Param name path string true "Job name"
+// @Success 202 {object} models.JobAdmission
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/namespaces/{namespace}/jobs/{name}/suspend [post]
+func (h Handlers) SuspendJob(c *fiber.Ctx) error {
+	if h.EnvClient.IsMockMode() {
+		return h.jobAction(c, "suspending", h.MockClient.SuspendJob)
+	}
+	return h.jobAction(c, "suspending", h.K8sClient.SuspendJob)
+}
+
+// ResumeJob resumes a suspended job
+// @Description Resume a job by reactivating its Kueue Workload so it queues for admission again. Jobs not managed by Kueue are unsuspended directly.
+// @Description The Workload is returned as it is right after it is reactivated, poll the job detail for the conditions Kueue sets afterwards.
+// @Summary Resume job
+// @Tags Job
+// @Produce json
+// @Param namespace path string true "Job namespace"
+// @Param name path string true "Job name"
+// @Success 202 {object} models.JobAdmission
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/namespaces/{namespace}/jobs/{name}/resume [post]
+func (h Handlers) ResumeJob(c *fiber.Ctx) error {
+	if h.EnvClient.IsMockMode() {
+		return h.jobAction(c, "resuming", h.MockClient.ResumeJob)
+	}
+	return h.jobAction(c, "resuming", h.K8sClient.ResumeJob)
+}
+
+// RequeueJob requeues a job
+// @Description Requeue a Kueue job by deleting its Workload, Kueue suspends the job and queues a new Workload for it
+// @Summary Requeue job
+// @Tags Job
+// @Produce json
+// @Param namespace path string true "Job namespace"
+// @Param name path string true "Job name"
+// @Success 202 {object} models.JobAdmission
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/namespaces/{namespace}/jobs/{name}/requeue [post]
+func (h Handlers) RequeueJob(c *fiber.Ctx) error {
+	if h.EnvClient.IsMockMode() {
+		return h.jobAction(c, "requeuing", h.MockClient.RequeueJob)
+	}
+	return h.jobAction(c, "requeuing", h.K8sClient.RequeueJob)
+}
+
+// jobAction runs an admission action on a job and accepts it with the admission as it is right after the action.
+// Kueue reconciles the Workload afterwards, the job detail reports the conditions it sets.
+func (h Handlers) jobAction(c *fiber.Ctx, verb string, action func(namespace, name string) (*models.JobAdmission, error)) error {
+	admission, err := action(c.Params("namespace"), c.Params("name"))
+	if err != nil {
+		log.Printf("failed %s job: %v", verb, err)
+		return sendClientError(c, "Job not found", err)
+	}
+	return c.Status(fiber.StatusAccepted).JSON(admission)
+}
+
 // validateJobSchema validates the job creation request
+//
+//revive:disable:cyclomatic
 func validateJobSchema(rawBody map[string]any) (*models.Job, error) {
-	if len(rawBody) != 1 {
-		return nil, fmt.Errorf("request must contain only 'name' field")
+	err := checkAllowedFields(rawBody, "args", "backoffLimit", "command", "completions", "env", "image", "name", "namespace", "parallelism", "priorityClass", "queue", "resources", "scheduler")
+	if err != nil {
+		return nil, err
 	}
 
-	nameValue, exists := rawBody["name"]
-	if !exists {
-		return nil, fmt.Errorf("field 'name' is required")
+	job := &models.Job{Namespace: "default", Scheduler: models.JobSchedulerKueue}
+
+	if job.Name, err = requiredString(rawBody, "name"); err != nil {
+		return nil, err
 	}
 
-	name, ok := nameValue.(string)
-	if !ok {
-		return nil, fmt.Errorf("field 'name' must be a string")
+	if job.Image, err = requiredString(rawBody, "image"); err != nil {
+		return nil, err
 	}
 
-	if name == "" {
-		return nil, fmt.Errorf("field 'name' cannot be empty")
+	if job.Queue, err = requiredString(rawBody, "queue"); err != nil {
+		return nil, err
 	}
 
-	return &models.Job{Name: name}, nil
-}
+	namespace, err := optionalString(rawBody, "namespace")
+	if err != nil {
+		return nil, err
+	}
+	if namespace != "" {
+		job.Namespace = namespace
+	}
 
-func (h Handlers) runJobInCluster(jobName string) error {
-	pod := &corev1.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: jobName,
-			Labels: map[string]string{
-				"app": "job",
-			},
-		},
-		Spec: corev1.PodSpec{
-			RestartPolicy: corev1.RestartPolicyNever,
-			Containers: []corev1.Container{
-				{
-					Name:  "job-container",
-					Image: "busybox:latest",
-					Command: []string{
-						"sh",
-						"-c",
-						"echo 'Job started' && sleep 10 && echo 'Job completed'",
-					},
-				},
-			},
-		},
-	}
-
-	_, err := h.K8sClient.Clientset.CoreV1().Pods("default").Create(context.TODO(), pod, metav1.CreateOptions{})
+	scheduler, err := optionalString(rawBody, "scheduler")
 	if err != nil {
-		return fmt.Errorf("failed to create pod: %w", err)
+		return nil, err
+	}
+	switch scheduler {
+	case "":
+	case models.JobSchedulerKueue, models.JobSchedulerKAI:
+		job.Scheduler = scheduler
+	default:
+		return nil, fmt.Errorf("field 'scheduler' must be one of %s, %s", models.JobSchedulerKueue, models.JobSchedulerKAI)
+	}
+
+	if job.PriorityClass, err = optionalString(rawBody, "priorityClass"); err != nil {
+		return nil, err
+	}
+	if job.PriorityClass != "" && job.Scheduler != models.JobSchedulerKueue {
+		return nil, fmt.Errorf("field 'priorityClass' is only supported for %s jobs", models.JobSchedulerKueue)
+	}
+
+	if job.Command, err = optionalStringSlice(rawBody, "command"); err != nil {
+		return nil, err
+	}
+
+	if job.Args, err = optionalStringSlice(rawBody, "args"); err != nil {
+		return nil, err
+	}
+
+	if job.Parallelism, err = optionalInt32(rawBody, "parallelism"); err != nil {
+		return nil, err
+	}
+	if job.Parallelism != nil && *job.Parallelism < 1 {
+		return nil, fmt.Errorf("field 'parallelism' must be at least 1")
+	}
+
+	if job.Completions, err = optionalInt32(rawBody, "completions"); err != nil {
+		return nil, err
+	}
+	if job.Completions != nil && *job.Completions < 1 {
+		return nil, fmt.Errorf("field 'completions' must be at least 1")
+	}
+
+	if job.BackoffLimit, err = optionalInt32(rawBody, "backoffLimit"); err != nil {
+		return nil, err
+	}
+	if job.BackoffLimit != nil && *job.BackoffLimit < 0 {
+		return nil, fmt.Errorf("field 'backoffLimit' cannot be negative")
 	}
 
-	return nil
+	if env, exists := rawBody["env"]; exists {
+		items, ok := env.([]any)
+		if !ok {
+			return nil, fmt.Errorf("field 'env' must be an array")
+		}
+		for _, item := range items {
+			e, ok := item.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("env items must be objects")
+			}
+			name, ok := e["name"].(string)
+			if !ok || name == "" {
+				return nil, fmt.Errorf("env items must have a 'name' string")
+			}
+			value, err := optionalString(e, "value")
+			if err != nil {
+				return nil, fmt.Errorf("env item '%s': %w", name, err)
+			}
+			job.Env = append(job.Env, models.EnvVar{Name: name, Value: value})
+		}
+	}
+
+	if resources, exists := rawBody["resources"]; exists {
+		r, ok := resources.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("field 'resources' must be an object")
+		}
+		if err := checkAllowedFields(r, "limits", "requests"); err != nil {
+			return nil, fmt.Errorf("resources: %w", err)
+		}
+		if job.Resources.Requests, err = optionalQuantityMap(r, "requests"); err != nil {
+			return nil, err
+		}
+		if job.Resources.Limits, err = optionalQuantityMap(r, "limits"); err != nil {
+			return nil, err
+		}
+	}
+
+	return job, nil
 }
+
+//revive:enable:cyclomatic
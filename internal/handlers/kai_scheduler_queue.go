@@ -2,14 +2,20 @@ package handlers
 
 import (
 	"cmyk/internal/models"
+
+	"fmt"
 	"log"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 // ReadKaiSchedulerQueues returns kai scheduler parent queues as JSON
-// @Description Get kai scheduler parent queues
+// @Description Get kai scheduler parent queues with the resources allocated to and requested by each queue and its children.
+// @Description Utilisation is given in the units of the quota (millicores, megabytes, devices) and as a percentage of the quota and limit.
 // @Summary Get kai scheduler parent queues
 // @Tags KaiSchedulerQueues
 // @Produce json
@@ -32,11 +38,89 @@ func (h Handlers) ReadKaiSchedulerQueues(c *fiber.Ctx) error {
 	if len(queues) == 0 {
 		return c.SendStatus(fiber.StatusNoContent)
 	}
+	for _, q := range queues {
+		setKaiSchedulerQueueUtilisation(q.Status, q.Resources)
+	}
 	return c.JSON(queues)
 }
 
+// ReadKaiSchedulerQueueTree returns the kai scheduler queue hierarchy as JSON
+// @Description Get the full kai scheduler queue hierarchy to any depth with the resources and utilisation of every queue.
+// @Description Queues whose parent does not exist are flagged as orphans and queues that are each other's ancestors are reported as cycles,
+// @Description both are returned at the top level so no queue is left out.
+// @Summary Get kai scheduler queue tree
+// @Tags KaiSchedulerQueues
+// @Produce json
+// @Success 200 {object} models.KaiSchedulerQueueTree
+// @Router /api/v1/kai-scheduler-queues/tree [get]
+func (h Handlers) ReadKaiSchedulerQueueTree(c *fiber.Ctx) error {
+	queues, err := h.listKaiSchedulerQueues()
+	if err != nil {
+		log.Printf("failed reading kai scheduler queue tree: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading kai scheduler queue tree"})
+	}
+	for _, q := range queues {
+		setKaiSchedulerQueueUtilisation(q.Status, q.Resources)
+	}
+
+	return c.JSON(buildKaiSchedulerQueueTree(queues))
+}
+
+// SimulateKaiSchedulerQueues simulates the fair share of every kai scheduler queue
+// @Description Simulate the share of cpu, gpu and memory every kai scheduler queue deserves for a demand per leaf queue, the way the scheduler divides
+// @Description the cluster: quota first, then the rest by priority and over quota weight, down every level of the hierarchy. Historical usage is not taken into account.
+// @Description The current queues are used unless a proposed set of queues is given, the capacity defaults to the allocatable resources of the ready nodes.
+// @Description Amounts are in the units of the quota: millicores, megabytes and devices. Nothing is changed in the cluster.
+// @Summary Simulate kai scheduler queue fair share
+// @Tags KaiSchedulerQueues
+// @Accept json
+// @Produce json
+// @Param simulation body object true "Demand per leaf queue, with optional capacity and queues"
+// @Success 200 {object} models.KaiSchedulerQueueSimulation
+// @Failure 400 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/kai-scheduler-queues/simulate [post]
+func (h Handlers) SimulateKaiSchedulerQueues(c *fiber.Ctx) error {
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	simulation, err := validateKaiSchedulerQueueSimulationSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	if simulation.queues == nil {
+		if simulation.queues, err = h.listKaiSchedulerQueues(); err != nil {
+			log.Printf("failed reading kai scheduler queues: %v", err)
+			return sendClientError(c, "Kai scheduler queue not found", err)
+		}
+	}
+	if err := validateKaiSchedulerSimulationQueues(simulation.queues, simulation.demand); err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	if simulation.capacity == nil {
+		var nodes []models.Node
+		if h.EnvClient.IsMockMode() {
+			nodes, err = h.MockClient.ListNodes()
+		} else {
+			nodes, err = h.K8sClient.ListNodes()
+		}
+		if err != nil {
+			log.Printf("failed reading nodes: %v", err)
+			return sendClientError(c, "Nodes not found", err)
+		}
+		capacity := kaiSchedulerNodeCapacity(nodes)
+		simulation.capacity = &capacity
+	}
+
+	return c.JSON(simulateKaiSchedulerFairShare(simulation.queues, *simulation.capacity, simulation.demand))
+}
+
 // ReadKaiSchedulerChildQueues returns child queues for a given parent queue as JSON
-// @Description Get child queues for a kai scheduler parent queue
+// @Description Get child queues for a kai scheduler parent queue with their allocated and requested resources and utilisation
 // @Summary Get kai scheduler child queues
 // @Tags KaiSchedulerQueues
 // @Produce json
@@ -66,5 +150,424 @@ func (h Handlers) ReadKaiSchedulerChildQueues(c *fiber.Ctx) error {
 	if len(queues) == 0 {
 		return c.SendStatus(fiber.StatusNoContent)
 	}
+	for _, q := range queues {
+		setKaiSchedulerQueueUtilisation(q.Status, q.Resources)
+	}
 	return c.JSON(queues)
 }
+
+// ReadKaiSchedulerQueueDetail returns a kai scheduler queue as JSON
+// @Description Get a kai scheduler queue at any level of the hierarchy with its parent, priority and resources.
+// @Description The status shows the resources allocated to and requested by the queue and its children, with the utilisation of its quota and limit.
+// @Description A queue holding more than its quota is over quota, a queue asking for more than it holds while under its quota is starving.
+// @Summary Get kai scheduler queue detail
+// @Tags KaiSchedulerQueues
+// @Produce json
+// @Param name path string true "Queue name"
+// @Success 200 {object} models.KaiSchedulerQueue
+// @Failure 404 {object} models.Error
+// @Router /api/v1/kai-scheduler-queues/{name} [get]
+func (h Handlers) ReadKaiSchedulerQueueDetail(c *fiber.Ctx) error {
+	var queue *models.KaiSchedulerQueue
+	var err error
+
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		queue, err = h.MockClient.GetKaiSchedulerQueue(name)
+	} else {
+		queue, err = h.K8sClient.GetKaiSchedulerQueue(name)
+	}
+	if err != nil {
+		log.Printf("failed reading kai scheduler queue: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Kai scheduler queue not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading kai scheduler queue"})
+	}
+	setKaiSchedulerQueueUtilisation(queue.Status, queue.Resources)
+	return c.JSON(queue)
+}
+
+// CreateKaiSchedulerQueue creates a new kai scheduler queue
+// @Description Create a new kai scheduler queue, at the top level or under an existing parent queue.
+// @Description Resources left out default to no quota, no limit and an over quota weight of 1. A quota or limit of -1 is unlimited.
+// @Description The quotas of the children of a queue cannot add up to more than its own quota.
+// @Summary Create kai scheduler queue
+// @Tags KaiSchedulerQueues
+// @Accept json
+// @Produce json
+// @Param queue body models.KaiSchedulerQueue true "Queue to create"
+// @Success 201 {object} models.KaiSchedulerQueue
+// @Failure 400 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/kai-scheduler-queues [post]
+func (h Handlers) CreateKaiSchedulerQueue(c *fiber.Ctx) error {
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	queue, err := validateKaiSchedulerQueueSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	queues, err := h.listKaiSchedulerQueues()
+	if err != nil {
+		log.Printf("failed reading kai scheduler queues: %v", err)
+		return sendClientError(c, "Kai scheduler queue not found", err)
+	}
+	if err := validateKaiSchedulerQueueHierarchy(queues, *queue); err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	var created *models.KaiSchedulerQueue
+	if h.EnvClient.IsMockMode() {
+		created, err = h.MockClient.CreateKaiSchedulerQueue(*queue)
+	} else {
+		created, err = h.K8sClient.CreateKaiSchedulerQueue(*queue)
+	}
+	if err != nil {
+		log.Printf("failed creating kai scheduler queue: %v", err)
+		return sendClientError(c, "Kai scheduler queue not found", err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(created)
+}
+
+// UpdateKaiSchedulerQueue replaces the spec of a kai scheduler queue
+// @Description Replace the parent, display name, priority and resources of a kai scheduler queue.
+// @Description The quotas of the children of a queue cannot add up to more than its own quota, and a queue cannot be moved under itself or one of its descendants.
+// @Description When resource_version is given and the queue has been modified since, 409 is returned.
+// @Summary Update kai scheduler queue
+// @Tags KaiSchedulerQueues
+// @Accept json
+// @Produce json
+// @Param name path string true "Queue name"
+// @Param queue body models.KaiSchedulerQueue true "Queue spec"
+// @Success 200 {object} models.KaiSchedulerQueue
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/kai-scheduler-queues/{name} [put]
+func (h Handlers) UpdateKaiSchedulerQueue(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	if _, exists := rawBody["name"]; !exists {
+		rawBody["name"] = name
+	}
+
+	resourceVersion, err := optionalString(rawBody, "resource_version")
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+	delete(rawBody, "resource_version")
+
+	queue, err := validateKaiSchedulerQueueSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+	if queue.Name != name {
+		return sendBadRequest(c, "field 'name' cannot be changed")
+	}
+	queue.ResourceVersion = resourceVersion
+
+	queues, err := h.listKaiSchedulerQueues()
+	if err != nil {
+		log.Printf("failed reading kai scheduler queues: %v", err)
+		return sendClientError(c, "Kai scheduler queue not found", err)
+	}
+	if err := validateKaiSchedulerQueueHierarchy(queues, *queue); err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	var updated *models.KaiSchedulerQueue
+	if h.EnvClient.IsMockMode() {
+		updated, err = h.MockClient.UpdateKaiSchedulerQueue(*queue)
+	} else {
+		updated, err = h.K8sClient.UpdateKaiSchedulerQueue(*queue)
+	}
+	if err != nil {
+		log.Printf("failed updating kai scheduler queue: %v", err)
+		return sendClientError(c, "Kai scheduler queue not found", err)
+	}
+
+	return c.JSON(updated)
+}
+
+// DeleteKaiSchedulerQueue deletes a kai scheduler queue
+// @Description Delete a kai scheduler queue. Queues that still have child queues or pod groups with bound or running pods cannot be deleted.
+// @Summary Delete kai scheduler queue
+// @Tags KaiSchedulerQueues
+// @Produce json
+// @Param name path string true "Queue name"
+// @Success 204
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/kai-scheduler-queues/{name} [delete]
+func (h Handlers) DeleteKaiSchedulerQueue(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	queues, err := h.listKaiSchedulerQueues()
+	if err != nil {
+		log.Printf("failed reading kai scheduler queues: %v", err)
+		return sendClientError(c, "Kai scheduler queue not found", err)
+	}
+	if !slices.ContainsFunc(queues, func(q models.KaiSchedulerQueue) bool { return q.Name == name }) {
+		return c.Status(404).JSON(fiber.Map{"error": "Kai scheduler queue not found"})
+	}
+
+	var children []string
+	for _, q := range queues {
+		if q.Parent == name {
+			children = append(children, q.Name)
+		}
+	}
+	if len(children) > 0 {
+		sort.Strings(children)
+		return sendConflict(c, fmt.Sprintf("kai scheduler queue %s has child queues: %s", name, strings.Join(children, ", ")))
+	}
+
+	var podGroups []models.PodGroup
+	if h.EnvClient.IsMockMode() {
+		podGroups, err = h.MockClient.ListPodGroups("", name)
+	} else {
+		podGroups, err = h.K8sClient.ListPodGroups("", name)
+	}
+	if err != nil {
+		log.Printf("failed reading pod groups: %v", err)
+		return sendClientError(c, "Pod group not found", err)
+	}
+
+	// Bound pods are placed on a node but may not be running yet, deleting the queue would orphan them too
+	var active []string
+	for _, pg := range podGroups {
+		if pg.Running > 0 || pg.Bound > 0 {
+			active = append(active, pg.Namespace+"/"+pg.Name)
+		}
+	}
+	if len(active) > 0 {
+		sort.Strings(active)
+		return sendConflict(c, fmt.Sprintf("kai scheduler queue %s has pod groups with bound or running pods: %s", name, strings.Join(active, ", ")))
+	}
+
+	if h.EnvClient.IsMockMode() {
+		err = h.MockClient.DeleteKaiSchedulerQueue(name)
+	} else {
+		err = h.K8sClient.DeleteKaiSchedulerQueue(name)
+	}
+	if err != nil {
+		log.Printf("failed deleting kai scheduler queue: %v", err)
+		return sendClientError(c, "Kai scheduler queue not found", err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// listKaiSchedulerQueues returns all the queues of the kai scheduler hierarchy
+func (h Handlers) listKaiSchedulerQueues() ([]models.KaiSchedulerQueue, error) {
+	if h.EnvClient.IsMockMode() {
+		return h.MockClient.ListKaiSchedulerQueues()
+	}
+	return h.K8sClient.ListKaiSchedulerQueues()
+}
+
+// validateKaiSchedulerQueueHierarchy checks that the parent of a queue exists and is not the queue or one of its descendants,
+// that the ancestors of the queue do not already form a cycle, and that neither the queue's siblings nor its own children end up with more quota than their parent
+func validateKaiSchedulerQueueHierarchy(queues []models.KaiSchedulerQueue, queue models.KaiSchedulerQueue) error {
+	byName := make(map[string]models.KaiSchedulerQueue, len(queues)+1)
+	for _, q := range queues {
+		byName[q.Name] = q
+	}
+	byName[queue.Name] = queue
+
+	if queue.Parent != "" {
+		if _, exists := byName[queue.Parent]; !exists {
+			return fmt.Errorf("parent queue '%s' does not exist", queue.Parent)
+		}
+		visited := map[string]bool{}
+		for ancestor := queue.Parent; ancestor != ""; ancestor = byName[ancestor].Parent {
+			if ancestor == queue.Name {
+				return fmt.Errorf("queue '%s' cannot be moved under its own descendant '%s'", queue.Name, queue.Parent)
+			}
+			if visited[ancestor] {
+				return fmt.Errorf("the parents of queue '%s' form a cycle through queue '%s'", queue.Parent, ancestor)
+			}
+			visited[ancestor] = true
+		}
+	}
+
+	for _, parent := range []string{queue.Parent, queue.Name} {
+		if parent == "" {
+			continue
+		}
+		var children []models.KaiSchedulerQueueResources
+		for _, q := range byName {
+			if q.Parent == parent {
+				children = append(children, q.Resources)
+			}
+		}
+		if err := checkKaiSchedulerChildQuotas(byName[parent], children); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkKaiSchedulerChildQuotas checks that the quotas of the children of a queue add up to no more than its own, per resource.
+// An unlimited parent quota accepts any children, an unlimited child quota only fits under an unlimited parent.
+func checkKaiSchedulerChildQuotas(parent models.KaiSchedulerQueue, children []models.KaiSchedulerQueueResources) error {
+	resources := []struct {
+		name  string
+		quota func(models.KaiSchedulerQueueResources) float64
+	}{
+		{"cpu", func(r models.KaiSchedulerQueueResources) float64 { return r.Cpu.Quota }},
+		{"gpu", func(r models.KaiSchedulerQueueResources) float64 { return r.Gpu.Quota }},
+		{"memory", func(r models.KaiSchedulerQueueResources) float64 {
+			if r.Memory == nil {
+				return 0
+			}
+			return r.Memory.Quota
+		}},
+	}
+
+	for _, res := range resources {
+		limit := res.quota(parent.Resources)
+		if limit < 0 {
+			continue
+		}
+
+		var total float64
+		for _, child := range children {
+			quota := res.quota(child)
+			if quota < 0 {
+				return fmt.Errorf("children of queue '%s' have an unlimited %s quota which exceeds its %s quota of %g", parent.Name, res.name, res.name, limit)
+			}
+			total += quota
+		}
+		if total > limit {
+			return fmt.Errorf("children of queue '%s' have a %s quota of %g which exceeds its %s quota of %g", parent.Name, res.name, total, res.name, limit)
+		}
+	}
+	return nil
+}
+
+// validateKaiSchedulerQueueSchema validates the kai scheduler queue creation and update request
+func validateKaiSchedulerQueueSchema(rawBody map[string]any) (*models.KaiSchedulerQueue, error) {
+	err := checkAllowedFields(rawBody, "display_name", "name", "parent", "priority", "resources")
+	if err != nil {
+		return nil, err
+	}
+
+	queue := &models.KaiSchedulerQueue{}
+
+	if queue.Name, err = requiredString(rawBody, "name"); err != nil {
+		return nil, err
+	}
+
+	if queue.Parent, err = optionalString(rawBody, "parent"); err != nil {
+		return nil, err
+	}
+	if queue.Parent == queue.Name {
+		return nil, fmt.Errorf("queue '%s' cannot be its own parent", queue.Name)
+	}
+
+	if queue.DisplayName, err = optionalString(rawBody, "display_name"); err != nil {
+		return nil, err
+	}
+
+	priority, err := optionalInt32(rawBody, "priority")
+	if err != nil {
+		return nil, err
+	}
+	if priority != nil {
+		p := int(*priority)
+		queue.Priority = &p
+	}
+
+	resources, err := optionalObject(rawBody, "resources")
+	if err != nil {
+		return nil, err
+	}
+	if resources == nil {
+		resources = map[string]any{}
+	}
+	if err := checkAllowedFields(resources, "cpu", "gpu", "memory"); err != nil {
+		return nil, err
+	}
+
+	if queue.Resources.Cpu, err = validateKaiSchedulerQueueResourceSchema(resources, "cpu"); err != nil {
+		return nil, err
+	}
+	if queue.Resources.Gpu, err = validateKaiSchedulerQueueResourceSchema(resources, "gpu"); err != nil {
+		return nil, err
+	}
+	memory, err := validateKaiSchedulerQueueResourceSchema(resources, "memory")
+	if err != nil {
+		return nil, err
+	}
+	queue.Resources.Memory = &memory
+
+	return queue, nil
+}
+
+// validateKaiSchedulerQueueResourceSchema validates the quota, limit and over quota weight of one queue resource.
+// Missing values default to no quota, no limit and an over quota weight of 1.
+func validateKaiSchedulerQueueResourceSchema(resources map[string]any, field string) (models.KaiSchedulerQueueResource, error) {
+	result := models.KaiSchedulerQueueResource{Limit: -1, OverQuotaWeight: 1}
+
+	obj, err := optionalObject(resources, field)
+	if err != nil || obj == nil {
+		return result, err
+	}
+	if err := checkAllowedFields(obj, "limit", "over_quota_weight", "quota"); err != nil {
+		return result, err
+	}
+
+	quota, err := optionalNumber(obj, "quota")
+	if err != nil {
+		return result, err
+	}
+	if quota != nil {
+		if *quota < 0 && *quota != -1 {
+			return result, fmt.Errorf("field '%s.quota' must be -1 or at least 0", field)
+		}
+		result.Quota = *quota
+	}
+
+	limit, err := optionalNumber(obj, "limit")
+	if err != nil {
+		return result, err
+	}
+	if limit != nil {
+		if *limit < 0 && *limit != -1 {
+			return result, fmt.Errorf("field '%s.limit' must be -1 or at least 0", field)
+		}
+		result.Limit = *limit
+	}
+	if result.Limit >= 0 && (result.Quota < 0 || result.Limit < result.Quota) {
+		return result, fmt.Errorf("field '%s.limit' cannot be less than '%s.quota'", field, field)
+	}
+
+	weight, err := optionalNumber(obj, "over_quota_weight")
+	if err != nil {
+		return result, err
+	}
+	if weight != nil {
+		if *weight < 0 {
+			return result, fmt.Errorf("field '%s.over_quota_weight' cannot be negative", field)
+		}
+		result.OverQuotaWeight = *weight
+	}
+
+	return result, nil
+}
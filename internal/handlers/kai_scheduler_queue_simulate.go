@@ -0,0 +1,433 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// unlimitedKaiSchedulerQuantity is the quota or limit of a kai scheduler queue resource without a bound
+const unlimitedKaiSchedulerQuantity = -1
+
+// kaiSchedulerShare is one resource of a queue while the fair share of the cluster is divided
+type kaiSchedulerShare struct {
+	name            string
+	quota           float64
+	limit           float64
+	overQuotaWeight float64
+	priority        int
+	request         float64
+	quotaShare      float64
+	fairShare       float64
+}
+
+// requestable is the part of the request the limit of the queue allows
+func (s *kaiSchedulerShare) requestable() float64 {
+	if s.limit == unlimitedKaiSchedulerQuantity {
+		return s.request
+	}
+	return math.Min(s.limit, s.request)
+}
+
+func (s *kaiSchedulerShare) remainingRequested() float64 {
+	return math.Max(0, s.requestable()-s.fairShare)
+}
+
+func (s *kaiSchedulerShare) satisfied() bool {
+	return s.request <= s.fairShare || (s.limit != unlimitedKaiSchedulerQuantity && s.limit <= s.fairShare)
+}
+
+// kaiSchedulerQueueResource returns how a queue is configured for one resource, memory that is left out has no quota and no limit
+type kaiSchedulerQueueResource struct {
+	amount   func(a *models.KaiSchedulerQueueAmounts) *float64
+	resource func(r models.KaiSchedulerQueueResources) models.KaiSchedulerQueueResource
+}
+
+var kaiSchedulerQueueResources = []kaiSchedulerQueueResource{
+	{
+		amount:   func(a *models.KaiSchedulerQueueAmounts) *float64 { return &a.Cpu },
+		resource: func(r models.KaiSchedulerQueueResources) models.KaiSchedulerQueueResource { return r.Cpu },
+	},
+	{
+		amount:   func(a *models.KaiSchedulerQueueAmounts) *float64 { return &a.Gpu },
+		resource: func(r models.KaiSchedulerQueueResources) models.KaiSchedulerQueueResource { return r.Gpu },
+	},
+	{
+		amount: func(a *models.KaiSchedulerQueueAmounts) *float64 { return &a.Memory },
+		resource: func(r models.KaiSchedulerQueueResources) models.KaiSchedulerQueueResource {
+			if r.Memory == nil {
+				return models.KaiSchedulerQueueResource{Limit: unlimitedKaiSchedulerQuantity, OverQuotaWeight: 1}
+			}
+			return *r.Memory
+		},
+	},
+}
+
+// simulateKaiSchedulerFairShare divides the capacity of the cluster over a queue tree for a demand per leaf queue the way the
+// proportion plugin of the kai scheduler does. Every level of the tree divides the fair share of its parent, the top level divides the capacity:
+// each queue first gets its quota up to what it requests, scaled down when the quotas add up to more than there is, the rest goes to the queues of the highest priority first in proportion to their
+// over quota weight, in whole units, with the fractions left over handed out one unit at a time. Historical usage is not taken into account.
+// The queues must form a tree, as checked by buildKaiSchedulerQueueTree.
+func simulateKaiSchedulerFairShare(queues []models.KaiSchedulerQueue, capacity models.KaiSchedulerQueueAmounts,
+	demand map[string]models.KaiSchedulerQueueAmounts) models.KaiSchedulerQueueSimulation {
+
+	byName := make(map[string]models.KaiSchedulerQueue, len(queues))
+	children := make(map[string][]string)
+	for _, q := range queues {
+		byName[q.Name] = q
+		children[q.Parent] = append(children[q.Parent], q.Name)
+	}
+	for parent := range children {
+		sort.Strings(children[parent])
+	}
+
+	// The demand of a parent queue is the demand of all its descendants
+	requests := make(map[string]models.KaiSchedulerQueueAmounts, len(queues))
+	var sumRequests func(name string) models.KaiSchedulerQueueAmounts
+	sumRequests = func(name string) models.KaiSchedulerQueueAmounts {
+		request := demand[name]
+		for _, child := range children[name] {
+			childRequest := sumRequests(child)
+			for _, r := range kaiSchedulerQueueResources {
+				*r.amount(&request) += *r.amount(&childRequest)
+			}
+		}
+		requests[name] = request
+		return request
+	}
+	for _, name := range children[""] {
+		sumRequests(name)
+	}
+
+	result := models.KaiSchedulerQueueSimulation{
+		Capacity:    capacity,
+		Queues:      []models.KaiSchedulerQueueSimulatedShare{},
+		Unallocated: capacity,
+	}
+
+	shares := make(map[string]*models.KaiSchedulerQueueSimulatedShare, len(queues))
+	var order func(name string)
+	order = func(name string) {
+		q := byName[name]
+		share := models.KaiSchedulerQueueSimulatedShare{
+			Demand: requests[name],
+			Name:   name,
+			Parent: q.Parent,
+		}
+		if q.Priority != nil {
+			share.Priority = *q.Priority
+		}
+		result.Queues = append(result.Queues, share)
+		for _, child := range children[name] {
+			order(child)
+		}
+	}
+	for _, name := range children[""] {
+		order(name)
+	}
+	for i := range result.Queues {
+		shares[result.Queues[i].Name] = &result.Queues[i]
+	}
+
+	for _, r := range kaiSchedulerQueueResources {
+		var divide func(total float64, names []string)
+		divide = func(total float64, names []string) {
+			if len(names) == 0 {
+				return
+			}
+
+			level := make([]*kaiSchedulerShare, 0, len(names))
+			for _, name := range names {
+				q := byName[name]
+				resource := r.resource(q.Resources)
+				request := requests[name]
+				level = append(level, &kaiSchedulerShare{
+					name:            name,
+					quota:           resource.Quota,
+					limit:           resource.Limit,
+					overQuotaWeight: resource.OverQuotaWeight,
+					priority:        shares[name].Priority,
+					request:         *r.amount(&request),
+				})
+			}
+			divideKaiSchedulerShare(total, level)
+
+			for _, s := range level {
+				share := shares[s.name]
+				*r.amount(&share.QuotaShare) = s.quotaShare
+				*r.amount(&share.FairShare) = s.fairShare
+				*r.amount(&share.OverQuotaShare) = s.fairShare - s.quotaShare
+				*r.amount(&share.Starved) = math.Max(0, *r.amount(&share.Demand)-s.fairShare)
+				divide(s.fairShare, children[s.name])
+			}
+		}
+		divide(*r.amount(&capacity), children[""])
+
+		unallocated := r.amount(&result.Unallocated)
+		for _, name := range children[""] {
+			*unallocated -= *r.amount(&shares[name].FairShare)
+		}
+		*unallocated = math.Max(0, *unallocated)
+	}
+
+	return result
+}
+
+// divideKaiSchedulerShare divides an amount of one resource over the queues of one level of the tree
+func divideKaiSchedulerShare(total float64, level []*kaiSchedulerShare) {
+	remaining := total
+	for _, s := range level {
+		quota := s.quota
+		if quota == unlimitedKaiSchedulerQuantity {
+			quota = total
+		}
+		s.quotaShare = math.Min(quota, s.requestable())
+		remaining -= s.quotaShare
+	}
+
+	// The scheduler hands out quota even when the quotas add up to more than there is, the simulation scales
+	// the quota shares down instead so the shares of a level never add up to more than the level has
+	if remaining < 0 {
+		scale := total / (total - remaining)
+		for _, s := range level {
+			s.quotaShare *= scale
+		}
+		remaining = 0
+	}
+	for _, s := range level {
+		s.fairShare = s.quotaShare
+	}
+	if remaining <= 0 {
+		return
+	}
+
+	byPriority := make(map[int][]*kaiSchedulerShare)
+	var priorities []int
+	for _, s := range level {
+		if _, exists := byPriority[s.priority]; !exists {
+			priorities = append(priorities, s.priority)
+		}
+		byPriority[s.priority] = append(byPriority[s.priority], s)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(priorities)))
+
+	fractions := make(map[int]map[string]float64)
+	for _, priority := range priorities {
+		remaining, fractions[priority] = divideKaiSchedulerOverQuota(remaining, byPriority[priority])
+	}
+
+	// Over quota shares are given in whole units, what was rounded off goes to the queues with the largest fraction first
+	for _, priority := range priorities {
+		queues := byPriority[priority]
+		sort.SliceStable(queues, func(i, j int) bool {
+			return fractions[priority][queues[i].name] > fractions[priority][queues[j].name]
+		})
+		for _, s := range queues {
+			if remaining <= 0 {
+				return
+			}
+			if _, exists := fractions[priority][s.name]; !exists {
+				continue
+			}
+			unit := math.Min(1, remaining)
+			s.fairShare += unit
+			remaining -= unit
+		}
+	}
+}
+
+// divideKaiSchedulerOverQuota divides an amount in rounds over queues of the same priority in proportion to their over quota weight,
+// until every queue is satisfied or nothing is left. It returns what is left and the fractions each queue did not get.
+func divideKaiSchedulerOverQuota(total float64, queues []*kaiSchedulerShare) (float64, map[string]float64) {
+	fractions := make(map[string]float64)
+
+	for {
+		var weights float64
+		for _, s := range queues {
+			if !s.satisfied() && s.remainingRequested() > 0 {
+				weights += s.overQuotaWeight
+			}
+		}
+		if weights == 0 {
+			break
+		}
+
+		anotherRound := false
+		amount := total
+		for _, s := range queues {
+			if total == 0 {
+				break
+			}
+			if s.satisfied() || s.overQuotaWeight == 0 {
+				continue
+			}
+
+			requested := s.remainingRequested()
+			fairShare := amount * s.overQuotaWeight / weights
+
+			give := requested
+			if requested <= fairShare {
+				delete(fractions, s.name)
+			} else {
+				give = math.Floor(fairShare)
+				if fairShare-give > 0 {
+					fractions[s.name] = fairShare - give
+				}
+			}
+			if give == 0 {
+				continue
+			}
+
+			s.fairShare += give
+			total -= give
+			anotherRound = anotherRound || requested < fairShare
+		}
+
+		if !anotherRound || total == 0 {
+			break
+		}
+	}
+
+	return total, fractions
+}
+
+// kaiSchedulerQueueSimulationRequest is a validated simulation request, capacity and queues are nil when they are left out
+type kaiSchedulerQueueSimulationRequest struct {
+	capacity *models.KaiSchedulerQueueAmounts
+	demand   map[string]models.KaiSchedulerQueueAmounts
+	queues   []models.KaiSchedulerQueue
+}
+
+// validateKaiSchedulerQueueSimulationSchema validates the kai scheduler queue simulation request
+func validateKaiSchedulerQueueSimulationSchema(rawBody map[string]any) (*kaiSchedulerQueueSimulationRequest, error) {
+	if err := checkAllowedFields(rawBody, "capacity", "demand", "queues"); err != nil {
+		return nil, err
+	}
+
+	result := &kaiSchedulerQueueSimulationRequest{}
+
+	capacity, err := optionalObject(rawBody, "capacity")
+	if err != nil {
+		return nil, err
+	}
+	if capacity != nil {
+		amounts, err := validateKaiSchedulerQueueAmountsSchema(capacity, "capacity")
+		if err != nil {
+			return nil, err
+		}
+		result.capacity = &amounts
+	}
+
+	if _, exists := rawBody["demand"]; !exists {
+		return nil, fmt.Errorf("field 'demand' is required")
+	}
+	demand, err := optionalObject(rawBody, "demand")
+	if err != nil {
+		return nil, err
+	}
+	result.demand = make(map[string]models.KaiSchedulerQueueAmounts, len(demand))
+	for name, value := range demand {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("demand for queue '%s' must be an object", name)
+		}
+		if result.demand[name], err = validateKaiSchedulerQueueAmountsSchema(obj, "demand."+name); err != nil {
+			return nil, err
+		}
+	}
+
+	queues, err := optionalObjectSlice(rawBody, "queues")
+	if err != nil {
+		return nil, err
+	}
+	if queues != nil {
+		result.queues = []models.KaiSchedulerQueue{}
+	}
+	for i, obj := range queues {
+		queue, err := validateKaiSchedulerQueueSchema(obj)
+		if err != nil {
+			return nil, fmt.Errorf("queues item %d: %w", i, err)
+		}
+		if slices.ContainsFunc(result.queues, func(q models.KaiSchedulerQueue) bool { return q.Name == queue.Name }) {
+			return nil, fmt.Errorf("queue '%s' is given more than once", queue.Name)
+		}
+		result.queues = append(result.queues, *queue)
+	}
+
+	return result, nil
+}
+
+// validateKaiSchedulerQueueAmountsSchema validates an amount of cpu, gpu and memory, amounts left out are 0
+func validateKaiSchedulerQueueAmountsSchema(obj map[string]any, field string) (models.KaiSchedulerQueueAmounts, error) {
+	var result models.KaiSchedulerQueueAmounts
+	if err := checkAllowedFields(obj, "cpu", "gpu", "memory"); err != nil {
+		return result, err
+	}
+
+	for name, amount := range map[string]*float64{"cpu": &result.Cpu, "gpu": &result.Gpu, "memory": &result.Memory} {
+		value, err := optionalNumber(obj, name)
+		if err != nil {
+			return result, fmt.Errorf("field '%s.%s' must be a number", field, name)
+		}
+		if value == nil {
+			continue
+		}
+		if *value < 0 {
+			return result, fmt.Errorf("field '%s.%s' cannot be negative", field, name)
+		}
+		*amount = *value
+	}
+	return result, nil
+}
+
+// validateKaiSchedulerSimulationQueues checks that the queues form a tree and that demand is only placed on leaf queues, as only those run jobs
+func validateKaiSchedulerSimulationQueues(queues []models.KaiSchedulerQueue, demand map[string]models.KaiSchedulerQueueAmounts) error {
+	tree := buildKaiSchedulerQueueTree(queues)
+	if len(tree.Orphans) > 0 {
+		return fmt.Errorf("parent queue of queue '%s' does not exist", tree.Orphans[0])
+	}
+	if len(tree.Cycles) > 0 {
+		return fmt.Errorf("queues %s are each other's ancestors", strings.Join(tree.Cycles[0], ", "))
+	}
+
+	names := make([]string, 0, len(demand))
+	for name := range demand {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !slices.ContainsFunc(queues, func(q models.KaiSchedulerQueue) bool { return q.Name == name }) {
+			return fmt.Errorf("demand is given for queue '%s' which does not exist", name)
+		}
+		if slices.ContainsFunc(queues, func(q models.KaiSchedulerQueue) bool { return q.Parent == name }) {
+			return fmt.Errorf("demand is given for queue '%s' which is not a leaf queue", name)
+		}
+	}
+	return nil
+}
+
+// kaiSchedulerNodeCapacity returns the allocatable resources of the ready nodes in the units of the queue quotas
+func kaiSchedulerNodeCapacity(nodes []models.Node) models.KaiSchedulerQueueAmounts {
+	var result models.KaiSchedulerQueueAmounts
+	for _, node := range nodes {
+		if !node.Ready {
+			continue
+		}
+		for name, q := range schedulableResources(node.Allocatable) {
+			switch {
+			case name == "cpu":
+				result.Cpu += float64(q.MilliValue())
+			case name == "memory":
+				result.Memory += float64(q.Value()) / 1e6
+			default:
+				result.Gpu += q.AsApproximateFloat64()
+			}
+		}
+	}
+	return result
+}
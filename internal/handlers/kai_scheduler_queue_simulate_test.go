@@ -0,0 +1,229 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+	"testing"
+)
+
+func simulationQueue(name, parent string, priority int, gpu models.KaiSchedulerQueueResource) models.KaiSchedulerQueue {
+	return models.KaiSchedulerQueue{
+		Name:     name,
+		Parent:   parent,
+		Priority: &priority,
+		Resources: models.KaiSchedulerQueueResources{
+			Cpu: models.KaiSchedulerQueueResource{Limit: -1, OverQuotaWeight: 1},
+			Gpu: gpu,
+		},
+	}
+}
+
+func gpuResource(quota, limit, weight float64) models.KaiSchedulerQueueResource {
+	return models.KaiSchedulerQueueResource{Limit: limit, OverQuotaWeight: weight, Quota: quota}
+}
+
+func gpuDemand(gpus float64) models.KaiSchedulerQueueAmounts {
+	return models.KaiSchedulerQueueAmounts{Gpu: gpus}
+}
+
+//revive:disable:cyclomatic
+func TestSimulateKaiSchedulerFairShare(t *testing.T) {
+	tests := []struct {
+		name        string
+		queues      []models.KaiSchedulerQueue
+		capacity    float64
+		demand      map[string]models.KaiSchedulerQueueAmounts
+		fairShare   map[string]float64
+		quotaShare  map[string]float64
+		starved     map[string]float64
+		unallocated float64
+	}{
+		{
+			name: "quota first then over quota weight",
+			queues: []models.KaiSchedulerQueue{
+				simulationQueue("a", "", 0, gpuResource(2, -1, 1)),
+				simulationQueue("b", "", 0, gpuResource(2, -1, 3)),
+			},
+			capacity:   10,
+			demand:     map[string]models.KaiSchedulerQueueAmounts{"a": gpuDemand(10), "b": gpuDemand(10)},
+			fairShare:  map[string]float64{"a": 4, "b": 6},
+			quotaShare: map[string]float64{"a": 2, "b": 2},
+			starved:    map[string]float64{"a": 6, "b": 4},
+		},
+		{
+			name: "quota is only given up to the demand",
+			queues: []models.KaiSchedulerQueue{
+				simulationQueue("a", "", 0, gpuResource(6, -1, 1)),
+				simulationQueue("b", "", 0, gpuResource(2, -1, 1)),
+			},
+			capacity:   10,
+			demand:     map[string]models.KaiSchedulerQueueAmounts{"a": gpuDemand(1), "b": gpuDemand(10)},
+			fairShare:  map[string]float64{"a": 1, "b": 9},
+			quotaShare: map[string]float64{"a": 1, "b": 2},
+			starved:    map[string]float64{"a": 0, "b": 1},
+		},
+		{
+			name: "higher priority takes the over quota share first",
+			queues: []models.KaiSchedulerQueue{
+				simulationQueue("high", "", 100, gpuResource(0, -1, 1)),
+				simulationQueue("low", "", 0, gpuResource(2, -1, 1)),
+			},
+			capacity:   8,
+			demand:     map[string]models.KaiSchedulerQueueAmounts{"high": gpuDemand(6), "low": gpuDemand(8)},
+			fairShare:  map[string]float64{"high": 6, "low": 2},
+			quotaShare: map[string]float64{"high": 0, "low": 2},
+			starved:    map[string]float64{"high": 0, "low": 6},
+		},
+		{
+			name: "limit caps the share and the rest goes to the other queues",
+			queues: []models.KaiSchedulerQueue{
+				simulationQueue("capped", "", 0, gpuResource(0, 3, 1)),
+				simulationQueue("open", "", 0, gpuResource(0, -1, 1)),
+			},
+			capacity:  10,
+			demand:    map[string]models.KaiSchedulerQueueAmounts{"capped": gpuDemand(10), "open": gpuDemand(10)},
+			fairShare: map[string]float64{"capped": 3, "open": 7},
+			starved:   map[string]float64{"capped": 7, "open": 3},
+		},
+		{
+			name: "zero weight gets no over quota share",
+			queues: []models.KaiSchedulerQueue{
+				simulationQueue("a", "", 0, gpuResource(1, -1, 0)),
+				simulationQueue("b", "", 0, gpuResource(1, -1, 1)),
+			},
+			capacity:  6,
+			demand:    map[string]models.KaiSchedulerQueueAmounts{"a": gpuDemand(6), "b": gpuDemand(6)},
+			fairShare: map[string]float64{"a": 1, "b": 5},
+		},
+		{
+			name: "children divide the fair share of their parent",
+			queues: []models.KaiSchedulerQueue{
+				simulationQueue("dept", "", 0, gpuResource(4, -1, 1)),
+				simulationQueue("dept-a", "dept", 0, gpuResource(0, -1, 1)),
+				simulationQueue("dept-b", "dept", 0, gpuResource(0, -1, 1)),
+				simulationQueue("other", "", 0, gpuResource(4, -1, 1)),
+			},
+			capacity:   12,
+			demand:     map[string]models.KaiSchedulerQueueAmounts{"dept-a": gpuDemand(10), "other": gpuDemand(10)},
+			fairShare:  map[string]float64{"dept": 6, "dept-a": 6, "dept-b": 0, "other": 6},
+			quotaShare: map[string]float64{"dept": 4, "dept-a": 0, "other": 4},
+			starved:    map[string]float64{"dept": 4, "dept-a": 4, "other": 4},
+		},
+		{
+			name: "quotas over the capacity are scaled down",
+			queues: []models.KaiSchedulerQueue{
+				simulationQueue("a", "", 0, gpuResource(6, -1, 1)),
+				simulationQueue("b", "", 0, gpuResource(2, -1, 1)),
+			},
+			capacity:   4,
+			demand:     map[string]models.KaiSchedulerQueueAmounts{"a": gpuDemand(6), "b": gpuDemand(2)},
+			fairShare:  map[string]float64{"a": 3, "b": 1},
+			quotaShare: map[string]float64{"a": 3, "b": 1},
+			starved:    map[string]float64{"a": 3, "b": 1},
+		},
+		{
+			name: "capacity nobody demands is unallocated",
+			queues: []models.KaiSchedulerQueue{
+				simulationQueue("a", "", 0, gpuResource(2, -1, 1)),
+				simulationQueue("b", "", 0, gpuResource(-1, -1, 1)),
+			},
+			capacity:    10,
+			demand:      map[string]models.KaiSchedulerQueueAmounts{"a": gpuDemand(3), "b": gpuDemand(4)},
+			fairShare:   map[string]float64{"a": 3, "b": 4},
+			quotaShare:  map[string]float64{"a": 2, "b": 4},
+			unallocated: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := simulateKaiSchedulerFairShare(tt.queues, models.KaiSchedulerQueueAmounts{Gpu: tt.capacity}, tt.demand)
+
+			if len(result.Queues) != len(tt.queues) {
+				t.Fatalf("got %d queues, want %d", len(result.Queues), len(tt.queues))
+			}
+			shares := make(map[string]models.KaiSchedulerQueueSimulatedShare)
+			for _, share := range result.Queues {
+				shares[share.Name] = share
+			}
+
+			for name, want := range tt.fairShare {
+				if got := shares[name].FairShare.Gpu; got != want {
+					t.Errorf("fair share of %s = %g, want %g", name, got, want)
+				}
+			}
+			for name, want := range tt.quotaShare {
+				if got := shares[name].QuotaShare.Gpu; got != want {
+					t.Errorf("quota share of %s = %g, want %g", name, got, want)
+				}
+				if got, want := shares[name].OverQuotaShare.Gpu, shares[name].FairShare.Gpu-want; got != want {
+					t.Errorf("over quota share of %s = %g, want %g", name, got, want)
+				}
+			}
+			for name, want := range tt.starved {
+				if got := shares[name].Starved.Gpu; got != want {
+					t.Errorf("starved share of %s = %g, want %g", name, got, want)
+				}
+			}
+			if result.Unallocated.Gpu != tt.unallocated {
+				t.Errorf("unallocated = %g, want %g", result.Unallocated.Gpu, tt.unallocated)
+			}
+		})
+	}
+}
+
+func TestSimulateKaiSchedulerFairShareOrder(t *testing.T) {
+	queues := []models.KaiSchedulerQueue{
+		simulationQueue("b", "", 0, gpuResource(0, -1, 1)),
+		simulationQueue("a-child", "a", 0, gpuResource(0, -1, 1)),
+		simulationQueue("a", "", 0, gpuResource(0, -1, 1)),
+	}
+
+	result := simulateKaiSchedulerFairShare(queues, models.KaiSchedulerQueueAmounts{}, nil)
+
+	want := []string{"a", "a-child", "b"}
+	for i, share := range result.Queues {
+		if share.Name != want[i] {
+			t.Errorf("queue %d = %s, want %s", i, share.Name, want[i])
+		}
+	}
+}
+
+func TestValidateKaiSchedulerSimulationQueues(t *testing.T) {
+	queues := []models.KaiSchedulerQueue{
+		simulationQueue("dept", "", 0, gpuResource(4, -1, 1)),
+		simulationQueue("team", "dept", 0, gpuResource(2, -1, 1)),
+	}
+
+	tests := []struct {
+		name    string
+		queues  []models.KaiSchedulerQueue
+		demand  map[string]models.KaiSchedulerQueueAmounts
+		wantErr bool
+	}{
+		{name: "leaf queue", queues: queues, demand: map[string]models.KaiSchedulerQueueAmounts{"team": gpuDemand(1)}},
+		{name: "parent queue", queues: queues, demand: map[string]models.KaiSchedulerQueueAmounts{"dept": gpuDemand(1)}, wantErr: true},
+		{name: "unknown queue", queues: queues, demand: map[string]models.KaiSchedulerQueueAmounts{"nope": gpuDemand(1)}, wantErr: true},
+		{
+			name:    "missing parent",
+			queues:  []models.KaiSchedulerQueue{simulationQueue("team", "dept", 0, gpuResource(0, -1, 1))},
+			wantErr: true,
+		},
+		{
+			name: "parent cycle",
+			queues: []models.KaiSchedulerQueue{
+				simulationQueue("x", "y", 0, gpuResource(0, -1, 1)),
+				simulationQueue("y", "x", 0, gpuResource(0, -1, 1)),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateKaiSchedulerSimulationQueues(tt.queues, tt.demand)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
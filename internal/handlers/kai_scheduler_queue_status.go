@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"math"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// setKaiSchedulerQueueUtilisation fills in the utilisation of a queue status against the quota and limit of the queue.
+// The scheduler reports the status in kubernetes units, they are converted to the units of the queue resources:
+// millicores for cpu, megabytes for memory and devices for gpus.
+func setKaiSchedulerQueueUtilisation(status *models.KaiSchedulerQueueStatus, resources models.KaiSchedulerQueueResources) {
+	if status == nil {
+		return
+	}
+
+	status.Utilisation.Cpu = kaiSchedulerQueueUtilisation(status, resources.Cpu, func(list map[string]string) float64 {
+		return float64(parseStatusQuantity(list["cpu"]).MilliValue())
+	})
+	status.Utilisation.Gpu = kaiSchedulerQueueUtilisation(status, resources.Gpu, func(list map[string]string) float64 {
+		var gpus float64
+		for name, value := range list {
+			if isGPUResource(name) {
+				gpus += parseStatusQuantity(value).AsApproximateFloat64()
+			}
+		}
+		return gpus
+	})
+
+	// A queue without memory resources does not restrict memory
+	memory := models.KaiSchedulerQueueResource{Limit: -1, Quota: -1}
+	if resources.Memory != nil {
+		memory = *resources.Memory
+	}
+	status.Utilisation.Memory = kaiSchedulerQueueUtilisation(status, memory, func(list map[string]string) float64 {
+		return float64(parseStatusQuantity(list["memory"]).Value()) / 1e6
+	})
+}
+
+// kaiSchedulerQueueUtilisation compares the allocated and requested amount of one resource with its quota and limit
+func kaiSchedulerQueueUtilisation(status *models.KaiSchedulerQueueStatus, r models.KaiSchedulerQueueResource, amount func(map[string]string) float64) models.KaiSchedulerQueueUtilisation {
+	u := models.KaiSchedulerQueueUtilisation{
+		Allocated:               amount(status.Allocated),
+		AllocatedNonPreemptible: amount(status.AllocatedNonPreemptible),
+		Requested:               amount(status.Requested),
+	}
+
+	u.AllocatedOfQuota = percentageOf(u.Allocated, r.Quota)
+	u.AllocatedOfLimit = percentageOf(u.Allocated, r.Limit)
+	u.RequestedOfQuota = percentageOf(u.Requested, r.Quota)
+
+	unlimitedQuota := r.Quota < 0
+	u.OverQuota = !unlimitedQuota && u.Allocated > r.Quota
+	u.Starving = u.Requested > u.Allocated && (unlimitedQuota || u.Allocated < r.Quota)
+	return u
+}
+
+// percentageOf returns an amount as a percentage of a total rounded to one decimal, or nil when the total is unlimited or zero
+func percentageOf(amount, total float64) *float64 {
+	if total <= 0 {
+		return nil
+	}
+	p := math.Round(amount/total*1000) / 10
+	return &p
+}
+
+// parseStatusQuantity parses a quantity reported in a queue status, a missing or malformed quantity counts as zero
+func parseStatusQuantity(value string) *resource.Quantity {
+	q, err := resource.ParseQuantity(value)
+	if err != nil {
+		return &resource.Quantity{}
+	}
+	return &q
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+	"testing"
+)
+
+func quotaQueue(name, parent string, gpuQuota float64) models.KaiSchedulerQueue {
+	return models.KaiSchedulerQueue{
+		Name:   name,
+		Parent: parent,
+		Resources: models.KaiSchedulerQueueResources{
+			Cpu: models.KaiSchedulerQueueResource{Limit: -1, Quota: -1},
+			Gpu: models.KaiSchedulerQueueResource{Limit: -1, Quota: gpuQuota},
+		},
+	}
+}
+
+func TestValidateKaiSchedulerQueueHierarchy(t *testing.T) {
+	tests := []struct {
+		name    string
+		queues  []models.KaiSchedulerQueue
+		queue   models.KaiSchedulerQueue
+		wantErr bool
+	}{
+		{
+			name:   "new root queue",
+			queues: []models.KaiSchedulerQueue{quotaQueue("a", "", 4)},
+			queue:  quotaQueue("b", "", 4),
+		},
+		{
+			name:   "new child within the parent quota",
+			queues: []models.KaiSchedulerQueue{quotaQueue("a", "", 4), quotaQueue("b", "a", 2)},
+			queue:  quotaQueue("c", "a", 2),
+		},
+		{
+			name:    "missing parent",
+			queues:  []models.KaiSchedulerQueue{quotaQueue("a", "", 4)},
+			queue:   quotaQueue("b", "x", 1),
+			wantErr: true,
+		},
+		{
+			name:    "moved under its own descendant",
+			queues:  []models.KaiSchedulerQueue{quotaQueue("a", "", -1), quotaQueue("b", "a", -1), quotaQueue("c", "b", -1)},
+			queue:   quotaQueue("a", "c", -1),
+			wantErr: true,
+		},
+		{
+			name:    "existing queues already form a cycle",
+			queues:  []models.KaiSchedulerQueue{quotaQueue("x", "y", -1), quotaQueue("y", "x", -1)},
+			queue:   quotaQueue("z", "x", 1),
+			wantErr: true,
+		},
+		{
+			name:    "children exceed the parent quota",
+			queues:  []models.KaiSchedulerQueue{quotaQueue("a", "", 4), quotaQueue("b", "a", 3)},
+			queue:   quotaQueue("c", "a", 2),
+			wantErr: true,
+		},
+		{
+			name:    "lowered quota no longer covers the children",
+			queues:  []models.KaiSchedulerQueue{quotaQueue("a", "", 4), quotaQueue("b", "a", 3)},
+			queue:   quotaQueue("a", "", 2),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateKaiSchedulerQueueHierarchy(tt.queues, tt.queue)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateKaiSchedulerQueueHierarchy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckKaiSchedulerChildQuotas(t *testing.T) {
+	withMemory := func(q models.KaiSchedulerQueue, memoryQuota float64) models.KaiSchedulerQueue {
+		q.Resources.Memory = &models.KaiSchedulerQueueResource{Limit: -1, Quota: memoryQuota}
+		return q
+	}
+
+	tests := []struct {
+		name     string
+		parent   models.KaiSchedulerQueue
+		children []models.KaiSchedulerQueue
+		wantErr  bool
+	}{
+		{
+			name:   "no children",
+			parent: quotaQueue("a", "", 4),
+		},
+		{
+			name:     "children add up to the parent quota",
+			parent:   quotaQueue("a", "", 4),
+			children: []models.KaiSchedulerQueue{quotaQueue("b", "a", 1), quotaQueue("c", "a", 3)},
+		},
+		{
+			name:     "children exceed the parent quota",
+			parent:   quotaQueue("a", "", 4),
+			children: []models.KaiSchedulerQueue{quotaQueue("b", "a", 2), quotaQueue("c", "a", 3)},
+			wantErr:  true,
+		},
+		{
+			name:     "unlimited parent accepts unlimited children",
+			parent:   quotaQueue("a", "", -1),
+			children: []models.KaiSchedulerQueue{quotaQueue("b", "a", -1), quotaQueue("c", "a", 100)},
+		},
+		{
+			name:     "unlimited child exceeds a limited parent",
+			parent:   quotaQueue("a", "", 4),
+			children: []models.KaiSchedulerQueue{quotaQueue("b", "a", -1)},
+			wantErr:  true,
+		},
+		{
+			name:     "children without memory fit under a memory quota",
+			parent:   withMemory(quotaQueue("a", "", 4), 1024),
+			children: []models.KaiSchedulerQueue{quotaQueue("b", "a", 4)},
+		},
+		{
+			name:     "children exceed the parent memory quota",
+			parent:   withMemory(quotaQueue("a", "", 4), 1024),
+			children: []models.KaiSchedulerQueue{withMemory(quotaQueue("b", "a", 2), 512), withMemory(quotaQueue("c", "a", 2), 1024)},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var children []models.KaiSchedulerQueueResources
+			for _, child := range tt.children {
+				children = append(children, child.Resources)
+			}
+			err := checkKaiSchedulerChildQuotas(tt.parent, children)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkKaiSchedulerChildQuotas() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"slices"
+	"sort"
+)
+
+// buildKaiSchedulerQueueTree builds the kai scheduler queue hierarchy to any depth.
+// Queues whose parent does not exist are returned at the top level as orphans. Queues in a parent cycle cannot be
+// reached from a top level queue, each cycle is returned at the top level from its first queue by name.
+func buildKaiSchedulerQueueTree(queues []models.KaiSchedulerQueue) models.KaiSchedulerQueueTree {
+	byName := make(map[string]models.KaiSchedulerQueue, len(queues))
+	var names []string
+	for _, q := range queues {
+		byName[q.Name] = q
+		names = append(names, q.Name)
+	}
+	sort.Strings(names)
+
+	children := make(map[string][]string)
+	for _, name := range names {
+		if parent := byName[name].Parent; parent != "" {
+			children[parent] = append(children[parent], name)
+		}
+	}
+
+	tree := models.KaiSchedulerQueueTree{Queues: []models.KaiSchedulerQueueTreeNode{}}
+
+	visited := make(map[string]bool)
+	var queueNode func(name string) models.KaiSchedulerQueueTreeNode
+	queueNode = func(name string) models.KaiSchedulerQueueTreeNode {
+		visited[name] = true
+		q := byName[name]
+		node := models.KaiSchedulerQueueTreeNode{
+			DisplayName: q.DisplayName,
+			Name:        q.Name,
+			Parent:      q.Parent,
+			Priority:    q.Priority,
+			Resources:   q.Resources,
+			Status:      q.Status,
+		}
+		for _, child := range children[name] {
+			if !visited[child] {
+				node.ChildQueues = append(node.ChildQueues, queueNode(child))
+			}
+		}
+		return node
+	}
+
+	for _, name := range names {
+		parent := byName[name].Parent
+		if parent == "" {
+			tree.Queues = append(tree.Queues, queueNode(name))
+			continue
+		}
+		if _, exists := byName[parent]; !exists {
+			node := queueNode(name)
+			node.Orphan = true
+			tree.Queues = append(tree.Queues, node)
+			tree.Orphans = append(tree.Orphans, name)
+		}
+	}
+
+	// Queues left over are in a parent cycle or below one, follow the parents until a queue repeats to find the cycle
+	for _, name := range names {
+		if visited[name] {
+			continue
+		}
+
+		var path []string
+		current := name
+		for !slices.Contains(path, current) {
+			path = append(path, current)
+			current = byName[current].Parent
+		}
+		cycle := path[slices.Index(path, current):]
+
+		first := slices.Min(cycle)
+		if visited[first] {
+			continue
+		}
+		start := slices.Index(cycle, first)
+		tree.Cycles = append(tree.Cycles, append(slices.Clone(cycle[start:]), cycle[:start]...))
+
+		node := queueNode(first)
+		node.Cycle = true
+		tree.Queues = append(tree.Queues, node)
+	}
+
+	return tree
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+	"reflect"
+	"testing"
+)
+
+func queueTreeNode(q models.KaiSchedulerQueue, children ...models.KaiSchedulerQueueTreeNode) models.KaiSchedulerQueueTreeNode {
+	return models.KaiSchedulerQueueTreeNode{ChildQueues: children, Name: q.Name, Parent: q.Parent, Resources: q.Resources}
+}
+
+func TestBuildKaiSchedulerQueueTree(t *testing.T) {
+	root, child, grandchild := quotaQueue("root", "", 8), quotaQueue("child", "root", 4), quotaQueue("grandchild", "child", 2)
+	orphan := quotaQueue("orphan", "missing", 1)
+	x, y, belowCycle := quotaQueue("x", "y", -1), quotaQueue("y", "x", -1), quotaQueue("z", "y", -1)
+
+	cycleNode := queueTreeNode(x, queueTreeNode(y, queueTreeNode(belowCycle)))
+	cycleNode.Cycle = true
+	orphanNode := queueTreeNode(orphan)
+	orphanNode.Orphan = true
+
+	tests := []struct {
+		name   string
+		queues []models.KaiSchedulerQueue
+		want   models.KaiSchedulerQueueTree
+	}{
+		{
+			name: "no queues",
+			want: models.KaiSchedulerQueueTree{Queues: []models.KaiSchedulerQueueTreeNode{}},
+		},
+		{
+			name:   "queues are nested to any depth",
+			queues: []models.KaiSchedulerQueue{grandchild, child, root},
+			want: models.KaiSchedulerQueueTree{
+				Queues: []models.KaiSchedulerQueueTreeNode{queueTreeNode(root, queueTreeNode(child, queueTreeNode(grandchild)))},
+			},
+		},
+		{
+			name:   "queue whose parent does not exist is an orphan",
+			queues: []models.KaiSchedulerQueue{root, orphan},
+			want: models.KaiSchedulerQueueTree{
+				Orphans: []string{"orphan"},
+				Queues:  []models.KaiSchedulerQueueTreeNode{orphanNode, queueTreeNode(root)},
+			},
+		},
+		{
+			name:   "cycle is returned from its first queue with the queues below it",
+			queues: []models.KaiSchedulerQueue{belowCycle, y, x},
+			want: models.KaiSchedulerQueueTree{
+				Cycles: [][]string{{"x", "y"}},
+				Queues: []models.KaiSchedulerQueueTreeNode{cycleNode},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildKaiSchedulerQueueTree(tt.queues); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildKaiSchedulerQueueTree() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -146,6 +146,110 @@ func (h Handlers) DeleteLocalQueue(c *fiber.Ctx) error {
 	return c.SendStatus(fiber.StatusNoContent)
 }
 
+// UpdateLocalQueue replaces the spec of a local queue
+// @Description Replace the stop policy of a local queue, its cluster queue cannot be changed.
+// @Description When resourceVersion is given and the local queue has been modified since, 409 is returned.
+// @Summary Update local queue
+// @Tags LocalQueues
+// @Accept json
+// @Produce json
+// @Param namespace path string true "LocalQueue namespace"
+// @Param name path string true "LocalQueue name"
+// @Param localQueue body models.LocalQueue true "LocalQueue spec"
+// @Success 200 {object} models.LocalQueue
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/namespaces/{namespace}/local-queues/{name} [put]
+func (h Handlers) UpdateLocalQueue(c *fiber.Ctx) error {
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	return h.updateLocalQueue(c, rawBody)
+}
+
+// PatchLocalQueue applies a JSON merge patch to a local queue
+// @Description Apply a JSON merge patch (RFC 7386) to the clusterQueue, stopPolicy and resourceVersion of a local queue, null removes a field.
+// @Description The resourceVersion read before patching is used unless the patch gives one, so a concurrent edit returns 409.
+// @Summary Patch local queue
+// @Tags LocalQueues
+// @Accept json
+// @Produce json
+// @Param namespace path string true "LocalQueue namespace"
+// @Param name path string true "LocalQueue name"
+// @Param patch body models.LocalQueue true "JSON merge patch"
+// @Success 200 {object} models.LocalQueue
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/namespaces/{namespace}/local-queues/{name} [patch]
+func (h Handlers) PatchLocalQueue(c *fiber.Ctx) error {
+	namespace := c.Params("namespace")
+	name := c.Params("name")
+
+	var patch map[string]any
+	if err := c.BodyParser(&patch); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	var current *models.LocalQueue
+	var err error
+	if h.EnvClient.IsMockMode() {
+		current, err = h.MockClient.GetLocalQueue(namespace, name)
+	} else {
+		current, err = h.K8sClient.GetLocalQueue(namespace, name)
+	}
+	if err != nil {
+		log.Printf("failed reading local queue: %v", err)
+		return sendClientError(c, "Local queue not found", err)
+	}
+
+	rawBody, err := patchModel(current, patch, "clusterQueue", "name", "resourceVersion", "stopPolicy")
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	return h.updateLocalQueue(c, rawBody)
+}
+
+// updateLocalQueue validates a full local queue and replaces the spec of the local queue in the path with it
+func (h Handlers) updateLocalQueue(c *fiber.Ctx, rawBody map[string]any) error {
+	namespace := c.Params("namespace")
+	name := c.Params("name")
+
+	if _, exists := rawBody["name"]; !exists {
+		rawBody["name"] = name
+	}
+
+	lq, err := validateLocalQueueSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+	if lq.Name != name {
+		return sendBadRequest(c, "field 'name' cannot be changed")
+	}
+	if lq.ResourceVersion, err = optionalString(rawBody, "resourceVersion"); err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	var updated *models.LocalQueue
+	if h.EnvClient.IsMockMode() {
+		updated, err = h.MockClient.UpdateLocalQueue(namespace, *lq)
+	} else {
+		updated, err = h.K8sClient.UpdateLocalQueue(namespace, *lq)
+	}
+	if err != nil {
+		log.Printf("failed updating local queue: %v", err)
+		return sendClientError(c, "Local queue not found", err)
+	}
+
+	return c.JSON(updated)
+}
+
 func validateLocalQueueSchema(rawBody map[string]any) (*models.LocalQueue, error) {
 	nameValue, exists := rawBody["name"]
 	if !exists {
@@ -180,13 +284,11 @@ func validateLocalQueueSchema(rawBody map[string]any) (*models.LocalQueue, error
 		ClusterQueue: clusterQueue,
 	}
 
-	if stopPolicy, exists := rawBody["stopPolicy"]; exists {
-		sp, ok := stopPolicy.(string)
-		if !ok {
-			return nil, fmt.Errorf("field 'stopPolicy' must be a string")
-		}
-		lq.StopPolicy = sp
+	stopPolicy, err := optionalEnum(rawBody, "stopPolicy", "None", "Hold", "HoldAndDrain")
+	if err != nil {
+		return nil, err
 	}
+	lq.StopPolicy = stopPolicy
 
 	return lq, nil
 }
@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// defaultTopLimit is how many nodes and pods the top endpoint returns when no limit is given
+const defaultTopLimit = 10
+
+// metricsRetryInterval is how long the metrics API is not asked again once it was found unavailable
+const metricsRetryInterval = time.Minute
+
+// metricsAvailability remembers that the metrics API is unavailable, so listing nodes and pods does not ask it on every request
+type metricsAvailability struct {
+	mu      sync.Mutex
+	retryAt time.Time
+}
+
+// available reports whether the metrics API should be asked
+func (m *metricsAvailability) available() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return time.Now().After(m.retryAt)
+}
+
+// unavailable records that the metrics API cannot be reached until the retry interval passes
+func (m *metricsAvailability) unavailable() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.retryAt = time.Now().Add(metricsRetryInterval)
+}
+
+// ReadTop returns the nodes and pods using the most CPU or memory as JSON
+// @Description Get the nodes and pods using the most CPU or memory right now, as reported by the metrics API (metrics.k8s.io) of metrics-server.
+// @Description Node usage is a percentage of allocatable, pod usage a percentage of requests. Responds 503 when the metrics API is not installed.
+// @Summary Get top nodes and pods
+// @Tags Metrics
+// @Produce json
+// @Param sortBy query string false "cpu (default) or memory"
+// @Param limit query int false "Number of nodes and pods, 10 by default"
+// @Param namespace query string false "Only pods in this namespace"
+// @Success 200 {object} models.TopUsage
+// @Failure 400 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Failure 503 {object} models.Error
+// @Router /api/v1/top [get]
+func (h Handlers) ReadTop(c *fiber.Ctx) error {
+	sortBy := c.Query("sortBy", "cpu")
+	if sortBy != "cpu" && sortBy != "memory" {
+		return sendBadRequest(c, "query 'sortBy' must be one of cpu, memory")
+	}
+	limit := c.QueryInt("limit", defaultTopLimit)
+	if limit < 1 {
+		return sendBadRequest(c, "query 'limit' must be at least 1")
+	}
+	namespace := c.Query("namespace")
+
+	if !h.metrics.available() {
+		return sendMetricsUnavailable(c)
+	}
+
+	var nodeUsages []models.NodeUsage
+	var podUsages []models.PodUsage
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		nodeUsages, err = h.MockClient.ListNodeMetrics()
+	} else {
+		nodeUsages, err = h.K8sClient.ListNodeMetrics()
+	}
+	if err == nil {
+		if h.EnvClient.IsMockMode() {
+			podUsages, err = h.MockClient.ListPodMetrics()
+		} else {
+			podUsages, err = h.K8sClient.ListPodMetrics()
+		}
+	}
+	if err != nil {
+		log.Printf("failed reading metrics: %v", err)
+		if isMetricsUnavailable(err) {
+			h.metrics.unavailable()
+			return sendMetricsUnavailable(c)
+		}
+		return sendClientError(c, "Metrics not found", err)
+	}
+
+	// Usage is reported as a percentage of the allocatable resources of each node and the requests of each pod
+	var nodes []models.Node
+	if h.EnvClient.IsMockMode() {
+		nodes, err = h.MockClient.ListNodes()
+	} else {
+		nodes, err = h.K8sClient.ListNodes()
+	}
+	if err != nil {
+		log.Printf("failed reading nodes: %v", err)
+	}
+	allocatable := make(map[string]models.NodeResources, len(nodes))
+	for _, n := range nodes {
+		allocatable[n.Name] = n.Allocatable
+	}
+	for i, u := range nodeUsages {
+		nodeUsages[i].Usage = usageOf(u.Usage, allocatable[u.Name])
+	}
+
+	pods := make(map[string]models.Pod)
+	for _, nodePods := range h.listPodsByNode() {
+		for _, p := range nodePods {
+			pods[p.Namespace+"/"+p.Name] = p
+		}
+	}
+	result := models.TopUsage{Nodes: []models.NodeUsage{}, Pods: []models.PodUsage{}, SortBy: sortBy}
+	result.Nodes = append(result.Nodes, nodeUsages...)
+	for _, u := range podUsages {
+		if namespace != "" && u.Namespace != namespace {
+			continue
+		}
+		p := pods[u.Namespace+"/"+u.Name]
+		u.Node = p.Node
+		u.Usage = usageOf(u.Usage, p.Requests)
+		result.Pods = append(result.Pods, u)
+	}
+
+	sort.SliceStable(result.Nodes, func(i, j int) bool {
+		return usageAmount(result.Nodes[i].Usage, sortBy) > usageAmount(result.Nodes[j].Usage, sortBy)
+	})
+	sort.SliceStable(result.Pods, func(i, j int) bool {
+		return usageAmount(result.Pods[i].Usage, sortBy) > usageAmount(result.Pods[j].Usage, sortBy)
+	})
+	result.Nodes = result.Nodes[:min(limit, len(result.Nodes))]
+	result.Pods = result.Pods[:min(limit, len(result.Pods))]
+
+	return c.JSON(result)
+}
+
+// listNodeUsage returns the current usage of each node, or nil when the metrics API cannot be read
+func (h Handlers) listNodeUsage() map[string]models.MetricsUsage {
+	if !h.metrics.available() {
+		return nil
+	}
+
+	var usages []models.NodeUsage
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		usages, err = h.MockClient.ListNodeMetrics()
+	} else {
+		usages, err = h.K8sClient.ListNodeMetrics()
+	}
+	if err != nil {
+		log.Printf("failed reading node metrics: %v", err)
+		if isMetricsUnavailable(err) {
+			h.metrics.unavailable()
+		}
+		return nil
+	}
+
+	result := make(map[string]models.MetricsUsage, len(usages))
+	for _, u := range usages {
+		result[u.Name] = u.Usage
+	}
+	return result
+}
+
+// listPodUsage returns the current usage of each pod by namespace and name, or nil when the metrics API cannot be read
+func (h Handlers) listPodUsage() map[string]models.MetricsUsage {
+	if !h.metrics.available() {
+		return nil
+	}
+
+	var usages []models.PodUsage
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		usages, err = h.MockClient.ListPodMetrics()
+	} else {
+		usages, err = h.K8sClient.ListPodMetrics()
+	}
+	if err != nil {
+		log.Printf("failed reading pod metrics: %v", err)
+		if isMetricsUnavailable(err) {
+			h.metrics.unavailable()
+		}
+		return nil
+	}
+
+	result := make(map[string]models.MetricsUsage, len(usages))
+	for _, u := range usages {
+		result[u.Namespace+"/"+u.Name] = u.Usage
+	}
+	return result
+}
+
+// usageOf returns the usage with its CPU and memory as a percentage of the given allocatable resources or requests
+func usageOf(usage models.MetricsUsage, of map[string]string) models.MetricsUsage {
+	if total, exists := of["cpu"]; exists {
+		usage.CPUPercent = percentageOf(parseStatusQuantity(usage.CPU).AsApproximateFloat64(), parseStatusQuantity(total).AsApproximateFloat64())
+	}
+	if total, exists := of["memory"]; exists {
+		usage.MemoryPercent = percentageOf(parseStatusQuantity(usage.Memory).AsApproximateFloat64(), parseStatusQuantity(total).AsApproximateFloat64())
+	}
+	return usage
+}
+
+// usageAmount returns the CPU or memory usage to sort by
+func usageAmount(usage models.MetricsUsage, sortBy string) float64 {
+	amount := usage.CPU
+	if sortBy == "memory" {
+		amount = usage.Memory
+	}
+	return parseStatusQuantity(amount).AsApproximateFloat64()
+}
+
+// isMetricsUnavailable reports whether the metrics API is not installed or metrics-server is not serving it
+func isMetricsUnavailable(err error) bool {
+	return apierrors.IsNotFound(err) || apierrors.IsServiceUnavailable(err)
+}
+
+// sendMetricsUnavailable responds with a 503 when the metrics API cannot be reached
+func sendMetricsUnavailable(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusServiceUnavailable).JSON(models.Error{
+		Code:    fiber.StatusServiceUnavailable,
+		Message: utils.StatusMessage(fiber.StatusServiceUnavailable),
+		Reason:  "the metrics API (metrics.k8s.io) is not available, install metrics-server to see resource usage",
+	})
+}
@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// ReadMultiKueueClusters returns multikueue clusters as JSON
+// @Description Get the MultiKueue worker clusters registered on the manager cluster with their Active condition.
+// @Description Workloads are only dispatched to active clusters.
+// @Summary Get multikueue clusters
+// @Tags MultiKueue
+// @Produce json
+// @Success 200 {array} models.MultiKueueCluster
+// @Success 204
+// @Router /api/v1/multikueue-clusters [get]
+func (h Handlers) ReadMultiKueueClusters(c *fiber.Ctx) error {
+	var clusters []models.MultiKueueCluster
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		clusters, err = h.MockClient.ListMultiKueueClusters()
+	} else {
+		clusters, err = h.K8sClient.ListMultiKueueClusters()
+	}
+	if err != nil {
+		log.Printf("failed reading multikueue clusters: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading multikueue clusters"})
+	}
+	if len(clusters) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+	return c.JSON(clusters)
+}
+
+// ReadMultiKueueClusterDetail returns multikueue cluster detail as JSON
+// @Description Get MultiKueue worker cluster detail with its Active condition
+// @Summary Get multikueue cluster detail
+// @Tags MultiKueue
+// @Produce json
+// @Param name path string true "MultiKueueCluster name"
+// @Success 200 {object} models.MultiKueueCluster
+// @Failure 404 {object} models.Error
+// @Router /api/v1/multikueue-clusters/{name} [get]
+func (h Handlers) ReadMultiKueueClusterDetail(c *fiber.Ctx) error {
+	var cluster *models.MultiKueueCluster
+	var err error
+
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		cluster, err = h.MockClient.GetMultiKueueCluster(name)
+	} else {
+		cluster, err = h.K8sClient.GetMultiKueueCluster(name)
+	}
+	if err != nil {
+		log.Printf("failed reading multikueue cluster: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Multikueue cluster not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading multikueue cluster"})
+	}
+	return c.JSON(cluster)
+}
+
+// CreateMultiKueueCluster registers a new multikueue worker cluster
+// @Description Register a MultiKueue worker cluster from a kubeconfig Secret reference or a ClusterProfile.
+// @Description A Secret must exist in the namespace of the Kueue controller manager (KUEUE_NAMESPACE, kueue-system by default) and hold a "kubeconfig" key.
+// @Summary Create multikueue cluster
+// @Tags MultiKueue
+// @Accept json
+// @Produce json
+// @Param cluster body models.MultiKueueCluster true "MultiKueueCluster to create"
+// @Success 201 {object} models.MultiKueueCluster
+// @Failure 400 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/multikueue-clusters [post]
+func (h Handlers) CreateMultiKueueCluster(c *fiber.Ctx) error {
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	cluster, err := validateMultiKueueClusterSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	var created *models.MultiKueueCluster
+	if h.EnvClient.IsMockMode() {
+		created, err = h.MockClient.CreateMultiKueueCluster(*cluster)
+	} else {
+		created, err = h.K8sClient.CreateMultiKueueCluster(*cluster)
+	}
+	if err != nil {
+		log.Printf("failed creating multikueue cluster: %v", err)
+		return sendClientError(c, "Multikueue cluster not found", err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(created)
+}
+
+// DeleteMultiKueueCluster deletes a multikueue worker cluster
+// @Description Delete a MultiKueue worker cluster. Clusters still listed in a MultiKueueConfig cannot be deleted.
+// @Summary Delete multikueue cluster
+// @Tags MultiKueue
+// @Produce json
+// @Param name path string true "MultiKueueCluster name"
+// @Success 204
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/multikueue-clusters/{name} [delete]
+func (h Handlers) DeleteMultiKueueCluster(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var configs []models.MultiKueueConfig
+	var err error
+	if h.EnvClient.IsMockMode() {
+		configs, err = h.MockClient.ListMultiKueueConfigs()
+	} else {
+		configs, err = h.K8sClient.ListMultiKueueConfigs()
+	}
+	if err != nil {
+		log.Printf("failed reading multikueue configs: %v", err)
+		return sendClientError(c, "Multikueue config not found", err)
+	}
+
+	var usedBy []string
+	for _, mkc := range configs {
+		if slices.Contains(mkc.Clusters, name) {
+			usedBy = append(usedBy, mkc.Name)
+		}
+	}
+	if len(usedBy) > 0 {
+		return sendConflict(c, fmt.Sprintf("multikueue cluster %s is used by multikueue configs: %s", name, strings.Join(usedBy, ", ")))
+	}
+
+	if h.EnvClient.IsMockMode() {
+		err = h.MockClient.DeleteMultiKueueCluster(name)
+	} else {
+		err = h.K8sClient.DeleteMultiKueueCluster(name)
+	}
+	if err != nil {
+		log.Printf("failed deleting multikueue cluster: %v", err)
+		return sendClientError(c, "Multikueue cluster not found", err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// validateMultiKueueClusterSchema validates the multikueue cluster creation request, exactly one of kubeConfig and clusterProfile is required.
+// The kubeConfig location type defaults to Secret.
+func validateMultiKueueClusterSchema(rawBody map[string]any) (*models.MultiKueueCluster, error) {
+	err := checkAllowedFields(rawBody, "clusterProfile", "kubeConfig", "name")
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := &models.MultiKueueCluster{}
+
+	if cluster.Name, err = requiredString(rawBody, "name"); err != nil {
+		return nil, err
+	}
+
+	if cluster.ClusterProfile, err = optionalString(rawBody, "clusterProfile"); err != nil {
+		return nil, err
+	}
+
+	kubeConfig, err := optionalObject(rawBody, "kubeConfig")
+	if err != nil {
+		return nil, err
+	}
+	if kubeConfig != nil {
+		if err := checkAllowedFields(kubeConfig, "location", "locationType"); err != nil {
+			return nil, err
+		}
+		cluster.KubeConfig = &models.MultiKueueKubeConfig{}
+		if cluster.KubeConfig.Location, err = requiredString(kubeConfig, "location"); err != nil {
+			return nil, err
+		}
+		if len(cluster.KubeConfig.Location) > 256 {
+			return nil, fmt.Errorf("field 'kubeConfig.location' must be at most 256 characters")
+		}
+		locationType, err := optionalEnum(kubeConfig, "locationType", string(kueuev1beta2.SecretLocationType), string(kueuev1beta2.PathLocationType))
+		if err != nil {
+			return nil, err
+		}
+		if locationType == "" {
+			locationType = string(kueuev1beta2.SecretLocationType)
+		}
+		cluster.KubeConfig.LocationType = locationType
+	}
+
+	if (cluster.KubeConfig == nil) == (cluster.ClusterProfile == "") {
+		return nil, fmt.Errorf("exactly one of fields 'kubeConfig' and 'clusterProfile' is required")
+	}
+
+	return cluster, nil
+}
@@ -0,0 +1,285 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// ReadMultiKueueConfigs returns multikueue configs as JSON
+// @Description Get MultiKueue configs, the groups of worker clusters a multikueue admission check dispatches workloads to
+// @Summary Get multikueue configs
+// @Tags MultiKueue
+// @Produce json
+// @Success 200 {array} models.MultiKueueConfig
+// @Success 204
+// @Router /api/v1/multikueue-configs [get]
+func (h Handlers) ReadMultiKueueConfigs(c *fiber.Ctx) error {
+	var configs []models.MultiKueueConfig
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		configs, err = h.MockClient.ListMultiKueueConfigs()
+	} else {
+		configs, err = h.K8sClient.ListMultiKueueConfigs()
+	}
+	if err != nil {
+		log.Printf("failed reading multikueue configs: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading multikueue configs"})
+	}
+	if len(configs) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+	return c.JSON(configs)
+}
+
+// ReadMultiKueueConfigDetail returns multikueue config detail as JSON
+// @Description Get MultiKueue config detail
+// @Summary Get multikueue config detail
+// @Tags MultiKueue
+// @Produce json
+// @Param name path string true "MultiKueueConfig name"
+// @Success 200 {object} models.MultiKueueConfig
+// @Failure 404 {object} models.Error
+// @Router /api/v1/multikueue-configs/{name} [get]
+func (h Handlers) ReadMultiKueueConfigDetail(c *fiber.Ctx) error {
+	var config *models.MultiKueueConfig
+	var err error
+
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		config, err = h.MockClient.GetMultiKueueConfig(name)
+	} else {
+		config, err = h.K8sClient.GetMultiKueueConfig(name)
+	}
+	if err != nil {
+		log.Printf("failed reading multikueue config: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Multikueue config not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading multikueue config"})
+	}
+	return c.JSON(config)
+}
+
+// CreateMultiKueueConfig creates a new multikueue config
+// @Description Create a new MultiKueue config grouping 1 to 20 worker clusters, every MultiKueueCluster must exist
+// @Summary Create multikueue config
+// @Tags MultiKueue
+// @Accept json
+// @Produce json
+// @Param config body models.MultiKueueConfig true "MultiKueueConfig to create"
+// @Success 201 {object} models.MultiKueueConfig
+// @Failure 400 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/multikueue-configs [post]
+func (h Handlers) CreateMultiKueueConfig(c *fiber.Ctx) error {
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	config, err := validateMultiKueueConfigSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	missing, err := h.missingMultiKueueClusters(config.Clusters)
+	if err != nil {
+		log.Printf("failed checking multikueue config clusters: %v", err)
+		return sendClientError(c, "Multikueue cluster not found", err)
+	}
+	if len(missing) > 0 {
+		return sendBadRequest(c, "multikueue clusters not found: "+strings.Join(missing, ", "))
+	}
+
+	var created *models.MultiKueueConfig
+	if h.EnvClient.IsMockMode() {
+		created, err = h.MockClient.CreateMultiKueueConfig(*config)
+	} else {
+		created, err = h.K8sClient.CreateMultiKueueConfig(*config)
+	}
+	if err != nil {
+		log.Printf("failed creating multikueue config: %v", err)
+		return sendClientError(c, "Multikueue config not found", err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(created)
+}
+
+// UpdateMultiKueueConfig replaces the worker clusters of a multikueue config
+// @Description Replace the worker clusters of a MultiKueue config, every MultiKueueCluster must exist.
+// @Description Workloads already dispatched stay on their worker cluster. When resourceVersion is given and the config has been modified since, 409 is returned.
+// @Summary Update multikueue config
+// @Tags MultiKueue
+// @Accept json
+// @Produce json
+// @Param name path string true "MultiKueueConfig name"
+// @Param config body models.MultiKueueConfig true "MultiKueueConfig"
+// @Success 200 {object} models.MultiKueueConfig
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/multikueue-configs/{name} [put]
+func (h Handlers) UpdateMultiKueueConfig(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	if _, exists := rawBody["name"]; !exists {
+		rawBody["name"] = name
+	}
+
+	resourceVersion, err := optionalString(rawBody, "resourceVersion")
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+	delete(rawBody, "resourceVersion")
+
+	config, err := validateMultiKueueConfigSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+	if config.Name != name {
+		return sendBadRequest(c, "field 'name' cannot be changed")
+	}
+	config.ResourceVersion = resourceVersion
+
+	missing, err := h.missingMultiKueueClusters(config.Clusters)
+	if err != nil {
+		log.Printf("failed checking multikueue config clusters: %v", err)
+		return sendClientError(c, "Multikueue cluster not found", err)
+	}
+	if len(missing) > 0 {
+		return sendBadRequest(c, "multikueue clusters not found: "+strings.Join(missing, ", "))
+	}
+
+	var updated *models.MultiKueueConfig
+	if h.EnvClient.IsMockMode() {
+		updated, err = h.MockClient.UpdateMultiKueueConfig(*config)
+	} else {
+		updated, err = h.K8sClient.UpdateMultiKueueConfig(*config)
+	}
+	if err != nil {
+		log.Printf("failed updating multikueue config: %v", err)
+		return sendClientError(c, "Multikueue config not found", err)
+	}
+
+	return c.JSON(updated)
+}
+
+// DeleteMultiKueueConfig deletes a multikueue config
+// @Description Delete a MultiKueue config. Configs still referenced by an admission check cannot be deleted.
+// @Summary Delete multikueue config
+// @Tags MultiKueue
+// @Produce json
+// @Param name path string true "MultiKueueConfig name"
+// @Success 204
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/multikueue-configs/{name} [delete]
+func (h Handlers) DeleteMultiKueueConfig(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var checks []models.AdmissionCheck
+	var err error
+	if h.EnvClient.IsMockMode() {
+		checks, err = h.MockClient.ListAdmissionChecks()
+	} else {
+		checks, err = h.K8sClient.ListAdmissionChecks()
+	}
+	if err != nil {
+		log.Printf("failed reading admission checks: %v", err)
+		return sendClientError(c, "Admission check not found", err)
+	}
+
+	var usedBy []string
+	for _, ac := range checks {
+		if p := ac.Parameters; p != nil && p.APIGroup == kueuev1beta2.GroupVersion.Group && p.Kind == "MultiKueueConfig" && p.Name == name {
+			usedBy = append(usedBy, ac.Name)
+		}
+	}
+	if len(usedBy) > 0 {
+		return sendConflict(c, fmt.Sprintf("multikueue config %s is used by admission checks: %s", name, strings.Join(usedBy, ", ")))
+	}
+
+	if h.EnvClient.IsMockMode() {
+		err = h.MockClient.DeleteMultiKueueConfig(name)
+	} else {
+		err = h.K8sClient.DeleteMultiKueueConfig(name)
+	}
+	if err != nil {
+		log.Printf("failed deleting multikueue config: %v", err)
+		return sendClientError(c, "Multikueue config not found", err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// missingMultiKueueClusters returns the clusters of a multikueue config that do not exist
+func (h Handlers) missingMultiKueueClusters(clusters []string) ([]string, error) {
+	var existing []models.MultiKueueCluster
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		existing, err = h.MockClient.ListMultiKueueClusters()
+	} else {
+		existing, err = h.K8sClient.ListMultiKueueClusters()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed reading multikueue clusters: %w", err)
+	}
+
+	var missing []string
+	for _, name := range clusters {
+		if !slices.ContainsFunc(existing, func(e models.MultiKueueCluster) bool { return e.Name == name }) {
+			missing = append(missing, name)
+		}
+	}
+	return missing, nil
+}
+
+// validateMultiKueueConfigSchema validates the multikueue config creation and update request
+func validateMultiKueueConfigSchema(rawBody map[string]any) (*models.MultiKueueConfig, error) {
+	err := checkAllowedFields(rawBody, "clusters", "name")
+	if err != nil {
+		return nil, err
+	}
+
+	config := &models.MultiKueueConfig{}
+
+	if config.Name, err = requiredString(rawBody, "name"); err != nil {
+		return nil, err
+	}
+
+	if config.Clusters, err = optionalStringSlice(rawBody, "clusters"); err != nil {
+		return nil, err
+	}
+	if len(config.Clusters) == 0 || len(config.Clusters) > 20 {
+		return nil, fmt.Errorf("field 'clusters' must list between 1 and 20 clusters")
+	}
+	for i, name := range config.Clusters {
+		if name == "" {
+			return nil, fmt.Errorf("clusters items cannot be empty")
+		}
+		if slices.Contains(config.Clusters[:i], name) {
+			return nil, fmt.Errorf("cluster '%s' is listed more than once", name)
+		}
+	}
+
+	return config, nil
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+	"log"
+	"slices"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// allocatedResourceNames are the resources reported for every node, GPUs are added when the node or its pods have them
+var allocatedResourceNames = []string{"cpu", "memory", "ephemeral-storage"}
+
+// listPodsByNode returns the pods bound to each node, or nil when the pods cannot be read
+func (h Handlers) listPodsByNode() map[string][]models.Pod {
+	var pods []models.Pod
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		pods, err = h.MockClient.ListPods()
+	} else {
+		pods, err = h.K8sClient.ListPods()
+	}
+	if err != nil {
+		log.Printf("failed reading pods: %v", err)
+		return nil
+	}
+
+	result := make(map[string][]models.Pod)
+	for _, p := range pods {
+		if p.Node != "" {
+			result[p.Node] = append(result[p.Node], p)
+		}
+	}
+	for _, nodePods := range result {
+		sortPods(nodePods)
+	}
+	return result
+}
+
+// sortPods sorts pods by namespace and name
+func sortPods(pods []models.Pod) {
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+		return pods[i].Name < pods[j].Name
+	})
+}
+
+// nodeAllocated sums the requests and limits of the non-terminated pods on a node for cpu, memory, ephemeral storage and GPUs,
+// with their percentage of the allocatable amount, the way kubectl describe node reports allocated resources
+func nodeAllocated(allocatable models.NodeResources, pods []models.Pod) models.NodeAllocated {
+	requests := make(map[string]resource.Quantity)
+	limits := make(map[string]resource.Quantity)
+	for _, p := range pods {
+		if p.Status == "Succeeded" || p.Status == "Failed" {
+			continue
+		}
+		addQuantities(requests, parsePodResources(p.Requests))
+		addQuantities(limits, parsePodResources(p.Limits))
+	}
+
+	names := slices.Clone(allocatedResourceNames)
+	for _, list := range []models.NodeResources{allocatable, toNodeResources(requests)} {
+		for name := range list {
+			if isGPUResource(name) && !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+
+	result := make(models.NodeAllocated, len(names))
+	for _, name := range names {
+		total := parseStatusQuantity(allocatable[name])
+		requested := requests[name]
+		limit := limits[name]
+		result[name] = models.NodeAllocatedResource{
+			Allocatable:     total.String(),
+			Limits:          limit.String(),
+			LimitsPercent:   percentageOf(limit.AsApproximateFloat64(), total.AsApproximateFloat64()),
+			Requests:        requested.String(),
+			RequestsPercent: percentageOf(requested.AsApproximateFloat64(), total.AsApproximateFloat64()),
+		}
+	}
+	return result
+}
+
+// parsePodResources parses the requests or limits of a pod, malformed quantities are left out
+func parsePodResources(list map[string]string) map[string]resource.Quantity {
+	result := make(map[string]resource.Quantity, len(list))
+	for name, value := range list {
+		if q, err := resource.ParseQuantity(value); err == nil {
+			result[name] = q
+		}
+	}
+	return result
+}
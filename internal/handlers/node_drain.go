@@ -0,0 +1,232 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// defaultNodeDrainTimeoutSeconds is how long a drain waits for the pods to leave the node when no timeout is given
+const defaultNodeDrainTimeoutSeconds = 300
+
+// nodeDrains keeps the latest progress of the drain of every node, so a drain running in the background can be polled.
+// The progress only lives in the memory of this process: the service is meant to run as a single replica, with more
+// replicas a drain can only be polled on the replica that started it, and a restart forgets the drains.
+type nodeDrains struct {
+	mu     sync.Mutex
+	drains map[string]models.NodeDrain
+}
+
+func newNodeDrains() *nodeDrains {
+	return &nodeDrains{drains: make(map[string]models.NodeDrain)}
+}
+
+// start records a new drain of a node, unless the node is already being drained
+func (d *nodeDrains) start(drain models.NodeDrain) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if current, exists := d.drains[drain.Node]; exists && current.Status == models.NodeDrainRunning {
+		return false
+	}
+	d.drains[drain.Node] = drain
+	return true
+}
+
+func (d *nodeDrains) set(drain models.NodeDrain) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.drains[drain.Node] = drain
+}
+
+func (d *nodeDrains) get(node string) (models.NodeDrain, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	drain, exists := d.drains[node]
+	return drain, exists
+}
+
+// CordonNode marks a node unschedulable
+// @Description Cordon a node so no new pods are scheduled on it, the pods already running on it are left alone
+// @Summary Cordon node
+// @Tags Nodes
+// @Produce json
+// @Param name path string true "Node name"
+// @Success 200 {object} models.NodeDetail
+// @Failure 404 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/nodes/{name}/cordon [post]
+func (h Handlers) CordonNode(c *fiber.Ctx) error {
+	return h.setNodeUnschedulable(c, "cordoning", true)
+}
+
+// UncordonNode marks a node schedulable again
+// @Description Uncordon a node so pods are scheduled on it again
+// @Summary Uncordon node
+// @Tags Nodes
+// @Produce json
+// @Param name path string true "Node name"
+// @Success 200 {object} models.NodeDetail
+// @Failure 404 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/nodes/{name}/uncordon [post]
+func (h Handlers) UncordonNode(c *fiber.Ctx) error {
+	return h.setNodeUnschedulable(c, "uncordoning", false)
+}
+
+func (h Handlers) setNodeUnschedulable(c *fiber.Ctx, verb string, unschedulable bool) error {
+	var nodeDetail *models.NodeDetail
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		nodeDetail, err = h.MockClient.SetNodeUnschedulable(c.Params("name"), unschedulable)
+	} else {
+		nodeDetail, err = h.K8sClient.SetNodeUnschedulable(c.Params("name"), unschedulable)
+	}
+	if err != nil {
+		log.Printf("failed %s node: %v", verb, err)
+		return sendClientError(c, "Node not found", err)
+	}
+	return c.JSON(nodeDetail)
+}
+
+// DrainNode starts a drain of a node
+// @Description Drain a node in the background: the node is cordoned and its pods are evicted through the Eviction API, so PodDisruptionBudgets are respected.
+// @Description DaemonSet pods and static pods are skipped, pods without a controller are only evicted with force and pods with emptyDir volumes only with deleteEmptyDirData.
+// @Description The drain stops once the pods have left the node or the timeout (300 seconds by default) passes. Poll the progress with GET /api/v1/nodes/{name}/drain.
+// @Summary Drain node
+// @Tags Nodes
+// @Accept json
+// @Produce json
+// @Param name path string true "Node name"
+// @Param options body models.NodeDrainOptions false "Grace period, timeout, force and deleteEmptyDirData"
+// @Success 202 {object} models.NodeDrain
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/nodes/{name}/drain [post]
+func (h Handlers) DrainNode(c *fiber.Ctx) error {
+	rawBody := map[string]any{}
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&rawBody); err != nil {
+			return sendBadRequest(c, "Cannot parse JSON")
+		}
+	}
+
+	opts, err := validateNodeDrainSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	name := c.Params("name")
+	if h.EnvClient.IsMockMode() {
+		_, err = h.MockClient.GetNode(name)
+	} else {
+		_, err = h.K8sClient.GetNode(name)
+	}
+	if err != nil {
+		log.Printf("failed reading node: %v", err)
+		return sendClientError(c, "Node not found", err)
+	}
+
+	drain := models.NodeDrain{
+		Node:      name,
+		Options:   *opts,
+		StartedAt: time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+		Status:    models.NodeDrainRunning,
+	}
+	if !h.drains.start(drain) {
+		return sendConflict(c, fmt.Sprintf("node '%s' is already being drained", name))
+	}
+
+	go func() {
+		defer h.recoverNodeDrain(drain)
+		if h.EnvClient.IsMockMode() {
+			h.MockClient.DrainNode(name, *opts, h.drains.set)
+		} else {
+			h.K8sClient.DrainNode(name, *opts, h.drains.set)
+		}
+	}()
+
+	return c.Status(fiber.StatusAccepted).JSON(drain)
+}
+
+// recoverNodeDrain marks a drain failed when it panics, so it is not left running forever
+func (h Handlers) recoverNodeDrain(drain models.NodeDrain) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	log.Printf("failed draining node: %v", r)
+	if current, exists := h.drains.get(drain.Node); exists {
+		drain = current
+	}
+	drain.Status = models.NodeDrainFailed
+	drain.Error = fmt.Sprintf("drain stopped unexpectedly: %v", r)
+	drain.CompletedAt = time.Now().UTC().Format("2006-01-02T15:04:05Z")
+	h.drains.set(drain)
+}
+
+// ReadNodeDrain returns the progress of the latest drain of a node as JSON
+// @Description Get the progress of the latest drain of a node: the pods evicted, remaining on the node, blocked and skipped
+// @Summary Get node drain
+// @Tags Nodes
+// @Produce json
+// @Param name path string true "Node name"
+// @Success 200 {object} models.NodeDrain
+// @Failure 404 {object} models.Error
+// @Router /api/v1/nodes/{name}/drain [get]
+func (h Handlers) ReadNodeDrain(c *fiber.Ctx) error {
+	drain, exists := h.drains.get(c.Params("name"))
+	if !exists {
+		return c.Status(404).JSON(fiber.Map{"error": "Node drain not found"})
+	}
+	return c.JSON(drain)
+}
+
+// validateNodeDrainSchema validates the node drain request
+func validateNodeDrainSchema(rawBody map[string]any) (*models.NodeDrainOptions, error) {
+	if err := checkAllowedFields(rawBody, "deleteEmptyDirData", "force", "gracePeriodSeconds", "timeoutSeconds"); err != nil {
+		return nil, err
+	}
+
+	opts := &models.NodeDrainOptions{TimeoutSeconds: defaultNodeDrainTimeoutSeconds}
+	var err error
+
+	if opts.DeleteEmptyDirData, err = optionalBool(rawBody, "deleteEmptyDirData"); err != nil {
+		return nil, err
+	}
+	if opts.Force, err = optionalBool(rawBody, "force"); err != nil {
+		return nil, err
+	}
+
+	gracePeriod, err := optionalInt32(rawBody, "gracePeriodSeconds")
+	if err != nil {
+		return nil, err
+	}
+	if gracePeriod != nil {
+		if *gracePeriod < 0 {
+			return nil, fmt.Errorf("field 'gracePeriodSeconds' cannot be negative")
+		}
+		seconds := int64(*gracePeriod)
+		opts.GracePeriodSeconds = &seconds
+	}
+
+	timeout, err := optionalInt32(rawBody, "timeoutSeconds")
+	if err != nil {
+		return nil, err
+	}
+	if timeout != nil {
+		if *timeout <= 0 {
+			return nil, fmt.Errorf("field 'timeoutSeconds' must be at least 1")
+		}
+		opts.TimeoutSeconds = int64(*timeout)
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,371 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// reservedNodeKeyDomains are the label and taint key prefixes owned by Kubernetes, including their subdomains
+var reservedNodeKeyDomains = []string{"kubernetes.io", "k8s.io"}
+
+// nodeChange represents a change to the labels and taints of a node. Labels set to nil are removed, taints in set replace
+// a taint with the same key and effect, taints in remove match on key and, when given, effect.
+type nodeChange struct {
+	labels       map[string]*string
+	setTaints    []models.NodeTaint
+	removeTaints []models.NodeTaint
+}
+
+// UpdateNodeLabels adds, modifies and removes labels on a node
+// @Description Add or modify the labels in set and remove the labels in remove.
+// @Description Keys under kubernetes.io/ and k8s.io/ are reserved and rejected. The response lists the resource flavors that cover the node after the change.
+// @Summary Update node labels
+// @Tags Nodes
+// @Accept json
+// @Produce json
+// @Param name path string true "Node name"
+// @Param labels body object true "Labels to set and label keys to remove"
+// @Success 200 {object} models.NodeLabelsAndTaints
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/nodes/{name}/labels [patch]
+func (h Handlers) UpdateNodeLabels(c *fiber.Ctx) error {
+	return h.updateNode(c, validateNodeLabelsSchema)
+}
+
+// UpdateNodeTaints adds, modifies and removes taints on a node
+// @Description Add the taints in set, replacing a taint with the same key and effect, and remove the taints in remove, matched on key and, when given, effect.
+// @Description Keys under kubernetes.io/ and k8s.io/ are reserved and rejected. The response lists the resource flavors that cover the node after the change.
+// @Summary Update node taints
+// @Tags Nodes
+// @Accept json
+// @Produce json
+// @Param name path string true "Node name"
+// @Param taints body object true "Taints to set and taints to remove"
+// @Success 200 {object} models.NodeLabelsAndTaints
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/nodes/{name}/taints [patch]
+func (h Handlers) UpdateNodeTaints(c *fiber.Ctx) error {
+	return h.updateNode(c, validateNodeTaintsSchema)
+}
+
+// UpdateNodesLabels adds, modifies and removes labels on every node matching a label selector
+// @Description Add or modify the labels in set and remove the labels in remove on every node matching the selector.
+// @Description Keys under kubernetes.io/ and k8s.io/ are reserved and rejected. A node that fails to update reports its error, the other nodes are still changed.
+// @Summary Update labels of selected nodes
+// @Tags Nodes
+// @Accept json
+// @Produce json
+// @Param selector query string true "Label selector"
+// @Param labels body object true "Labels to set and label keys to remove"
+// @Success 200 {array} models.NodeLabelsAndTaints
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Router /api/v1/nodes/labels [patch]
+func (h Handlers) UpdateNodesLabels(c *fiber.Ctx) error {
+	return h.updateNodes(c, validateNodeLabelsSchema)
+}
+
+// UpdateNodesTaints adds, modifies and removes taints on every node matching a label selector
+// @Description Add the taints in set, replacing a taint with the same key and effect, and remove the taints in remove on every node matching the selector.
+// @Description Keys under kubernetes.io/ and k8s.io/ are reserved and rejected. A node that fails to update reports its error, the other nodes are still changed.
+// @Summary Update taints of selected nodes
+// @Tags Nodes
+// @Accept json
+// @Produce json
+// @Param selector query string true "Label selector"
+// @Param taints body object true "Taints to set and taints to remove"
+// @Success 200 {array} models.NodeLabelsAndTaints
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Router /api/v1/nodes/taints [patch]
+func (h Handlers) UpdateNodesTaints(c *fiber.Ctx) error {
+	return h.updateNodes(c, validateNodeTaintsSchema)
+}
+
+func (h Handlers) updateNode(c *fiber.Ctx, validate func(map[string]any) (*nodeChange, error)) error {
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	change, err := validate(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	node, err := h.changeNode(c.Params("name"), *change)
+	if err != nil {
+		log.Printf("failed updating node: %v", err)
+		return sendClientError(c, "Node not found", err)
+	}
+
+	flavors, flavorsErr := h.nodeFlavors()
+	return c.JSON(toNodeLabelsAndTaints(node, flavors, flavorsErr))
+}
+
+func (h Handlers) updateNodes(c *fiber.Ctx, validate func(map[string]any) (*nodeChange, error)) error {
+	query := c.Query("selector")
+	if query == "" {
+		return sendBadRequest(c, "query 'selector' is required")
+	}
+	selector, err := labels.Parse(query)
+	if err != nil {
+		return sendBadRequest(c, fmt.Sprintf("query 'selector' is invalid: %v", err))
+	}
+
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	change, err := validate(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	var nodes []models.Node
+	if h.EnvClient.IsMockMode() {
+		nodes, err = h.MockClient.ListNodes()
+	} else {
+		nodes, err = h.K8sClient.ListNodes()
+	}
+	if err != nil {
+		log.Printf("failed reading nodes: %v", err)
+		return sendClientError(c, "Nodes not found", err)
+	}
+
+	flavors, flavorsErr := h.nodeFlavors()
+	var result []models.NodeLabelsAndTaints
+	for _, n := range nodes {
+		if !selector.Matches(labels.Set(n.Labels)) {
+			continue
+		}
+
+		node, err := h.changeNode(n.Name, *change)
+		if err != nil {
+			log.Printf("failed updating node %s: %v", n.Name, err)
+			result = append(result, models.NodeLabelsAndTaints{Error: err.Error(), Name: n.Name})
+			continue
+		}
+		result = append(result, toNodeLabelsAndTaints(node, flavors, flavorsErr))
+	}
+	if len(result) == 0 {
+		return c.Status(404).JSON(fiber.Map{"error": "No nodes match the selector"})
+	}
+
+	return c.JSON(result)
+}
+
+// changeNode applies a change to a node. Taints are merged into the taints the node has at the time it is updated.
+func (h Handlers) changeNode(name string, change nodeChange) (*models.NodeDetail, error) {
+	var mergeTaints func([]models.NodeTaint) []models.NodeTaint
+	if len(change.setTaints) > 0 || len(change.removeTaints) > 0 {
+		mergeTaints = func(current []models.NodeTaint) []models.NodeTaint {
+			return mergeNodeTaints(current, change.setTaints, change.removeTaints)
+		}
+	}
+
+	if h.EnvClient.IsMockMode() {
+		return h.MockClient.UpdateNode(name, change.labels, mergeTaints)
+	}
+	return h.K8sClient.UpdateNode(name, change.labels, mergeTaints)
+}
+
+// nodeFlavors returns the resource flavors to match nodes against
+func (h Handlers) nodeFlavors() ([]models.ResourceFlavor, error) {
+	var flavors []models.ResourceFlavor
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		flavors, err = h.MockClient.ListResourceFlavors()
+	} else {
+		flavors, err = h.K8sClient.ListResourceFlavors()
+	}
+	if err != nil {
+		log.Printf("failed reading resource flavors: %v", err)
+	}
+	return flavors, err
+}
+
+// toNodeLabelsAndTaints returns the labels and taints of a changed node with the resource flavors that cover it.
+// The node has already been changed when the flavors cannot be read, so the flavors are reported as unknown with the error.
+func toNodeLabelsAndTaints(node *models.NodeDetail, flavors []models.ResourceFlavor, flavorsErr error) models.NodeLabelsAndTaints {
+	result := models.NodeLabelsAndTaints{
+		Labels: node.Labels,
+		Name:   node.Name,
+		Taints: node.Taints,
+	}
+	if flavorsErr != nil {
+		result.FlavorsError = flavorsErr.Error()
+		return result
+	}
+	result.Flavors = append([]string{}, flavorsForNode(flavors, node.Labels, node.Taints)...)
+	return result
+}
+
+// mergeNodeTaints removes the matching taints from the current taints, then adds the set taints in place of any taint with the same key and effect
+func mergeNodeTaints(current, set, remove []models.NodeTaint) []models.NodeTaint {
+	result := []models.NodeTaint{}
+	for _, t := range current {
+		kept := true
+		for _, r := range remove {
+			if t.Key == r.Key && (r.Effect == "" || t.Effect == r.Effect) {
+				kept = false
+				break
+			}
+		}
+		for _, s := range set {
+			if t.Key == s.Key && t.Effect == s.Effect {
+				kept = false
+				break
+			}
+		}
+		if kept {
+			result = append(result, t)
+		}
+	}
+	return append(result, set...)
+}
+
+// validateNodeLabelsSchema validates the node labels request
+func validateNodeLabelsSchema(rawBody map[string]any) (*nodeChange, error) {
+	if err := checkAllowedFields(rawBody, "remove", "set"); err != nil {
+		return nil, err
+	}
+
+	set, err := optionalStringMap(rawBody, "set")
+	if err != nil {
+		return nil, err
+	}
+	remove, err := optionalStringSlice(rawBody, "remove")
+	if err != nil {
+		return nil, err
+	}
+	if len(set) == 0 && len(remove) == 0 {
+		return nil, fmt.Errorf("field 'set' or 'remove' is required")
+	}
+
+	change := &nodeChange{labels: make(map[string]*string)}
+	for key, value := range set {
+		if err := validateNodeKey(key); err != nil {
+			return nil, err
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return nil, fmt.Errorf("label '%s' has an invalid value: %s", key, strings.Join(errs, ", "))
+		}
+		change.labels[key] = &value
+	}
+	for _, key := range remove {
+		if err := validateNodeKey(key); err != nil {
+			return nil, err
+		}
+		if _, exists := set[key]; exists {
+			return nil, fmt.Errorf("label '%s' cannot be both set and removed", key)
+		}
+		change.labels[key] = nil
+	}
+
+	return change, nil
+}
+
+// validateNodeTaintsSchema validates the node taints request
+func validateNodeTaintsSchema(rawBody map[string]any) (*nodeChange, error) {
+	if err := checkAllowedFields(rawBody, "remove", "set"); err != nil {
+		return nil, err
+	}
+
+	set, err := optionalObjectSlice(rawBody, "set")
+	if err != nil {
+		return nil, err
+	}
+	remove, err := optionalObjectSlice(rawBody, "remove")
+	if err != nil {
+		return nil, err
+	}
+	if len(set) == 0 && len(remove) == 0 {
+		return nil, fmt.Errorf("field 'set' or 'remove' is required")
+	}
+
+	change := &nodeChange{}
+	for _, item := range set {
+		taint, err := validateNodeTaintSchema(item, true)
+		if err != nil {
+			return nil, fmt.Errorf("set: %w", err)
+		}
+		change.setTaints = append(change.setTaints, taint)
+	}
+	for _, item := range remove {
+		taint, err := validateNodeTaintSchema(item, false)
+		if err != nil {
+			return nil, fmt.Errorf("remove: %w", err)
+		}
+		change.removeTaints = append(change.removeTaints, taint)
+	}
+
+	return change, nil
+}
+
+// validateNodeTaintSchema validates a taint, a taint to remove has no value and may leave out the effect
+func validateNodeTaintSchema(rawBody map[string]any, set bool) (models.NodeTaint, error) {
+	var taint models.NodeTaint
+	var err error
+
+	allowed := []string{"effect", "key"}
+	if set {
+		allowed = append(allowed, "value")
+	}
+	if err := checkAllowedFields(rawBody, allowed...); err != nil {
+		return taint, err
+	}
+
+	if taint.Key, err = requiredString(rawBody, "key"); err != nil {
+		return taint, err
+	}
+	if err := validateNodeKey(taint.Key); err != nil {
+		return taint, err
+	}
+
+	if taint.Value, err = optionalString(rawBody, "value"); err != nil {
+		return taint, err
+	}
+	if errs := validation.IsValidLabelValue(taint.Value); len(errs) > 0 {
+		return taint, fmt.Errorf("taint '%s' has an invalid value: %s", taint.Key, strings.Join(errs, ", "))
+	}
+
+	if taint.Effect, err = optionalEnum(rawBody, "effect", "NoExecute", "NoSchedule", "PreferNoSchedule"); err != nil {
+		return taint, err
+	}
+	if set && taint.Effect == "" {
+		return taint, fmt.Errorf("field 'effect' is required")
+	}
+
+	return taint, nil
+}
+
+// validateNodeKey checks a label or taint key is a qualified name outside the prefixes reserved for Kubernetes
+func validateNodeKey(key string) error {
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return fmt.Errorf("key '%s' is invalid: %s", key, strings.Join(errs, ", "))
+	}
+
+	prefix, _, found := strings.Cut(key, "/")
+	if !found {
+		return nil
+	}
+	for _, domain := range reservedNodeKeyDomains {
+		if prefix == domain || strings.HasSuffix(prefix, "."+domain) {
+			return fmt.Errorf("key '%s' uses the reserved prefix '%s/'", key, prefix)
+		}
+	}
+	return nil
+}
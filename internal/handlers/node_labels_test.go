@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+	"reflect"
+	"testing"
+)
+
+func TestMergeNodeTaints(t *testing.T) {
+	gpu := models.NodeTaint{Effect: "NoSchedule", Key: "nvidia.com/gpu", Value: "present"}
+	unreachable := models.NodeTaint{Effect: "NoExecute", Key: "node.kubernetes.io/unreachable", TimeAdded: "2026-01-02T03:04:05Z"}
+
+	tests := []struct {
+		name    string
+		current []models.NodeTaint
+		set     []models.NodeTaint
+		remove  []models.NodeTaint
+		want    []models.NodeTaint
+	}{
+		{
+			name:    "add to no taints",
+			current: nil,
+			set:     []models.NodeTaint{gpu},
+			want:    []models.NodeTaint{gpu},
+		},
+		{
+			name:    "other taints are kept as they are",
+			current: []models.NodeTaint{unreachable},
+			set:     []models.NodeTaint{gpu},
+			want:    []models.NodeTaint{unreachable, gpu},
+		},
+		{
+			name:    "set replaces the taint with the same key and effect",
+			current: []models.NodeTaint{gpu, unreachable},
+			set:     []models.NodeTaint{{Effect: "NoSchedule", Key: "nvidia.com/gpu", Value: "absent"}},
+			want:    []models.NodeTaint{unreachable, {Effect: "NoSchedule", Key: "nvidia.com/gpu", Value: "absent"}},
+		},
+		{
+			name:    "set keeps the taint with the same key and another effect",
+			current: []models.NodeTaint{gpu},
+			set:     []models.NodeTaint{{Effect: "NoExecute", Key: "nvidia.com/gpu"}},
+			want:    []models.NodeTaint{gpu, {Effect: "NoExecute", Key: "nvidia.com/gpu"}},
+		},
+		{
+			name:    "remove without effect matches every effect",
+			current: []models.NodeTaint{gpu, {Effect: "NoExecute", Key: "nvidia.com/gpu"}, unreachable},
+			remove:  []models.NodeTaint{{Key: "nvidia.com/gpu"}},
+			want:    []models.NodeTaint{unreachable},
+		},
+		{
+			name:    "remove with effect matches that effect only",
+			current: []models.NodeTaint{gpu, {Effect: "NoExecute", Key: "nvidia.com/gpu"}},
+			remove:  []models.NodeTaint{{Effect: "NoExecute", Key: "nvidia.com/gpu"}},
+			want:    []models.NodeTaint{gpu},
+		},
+		{
+			name:    "remove of a missing taint",
+			current: []models.NodeTaint{gpu},
+			remove:  []models.NodeTaint{{Key: "example.com/missing"}},
+			want:    []models.NodeTaint{gpu},
+		},
+		{
+			name:    "remove every taint",
+			current: []models.NodeTaint{gpu},
+			remove:  []models.NodeTaint{{Key: "nvidia.com/gpu"}},
+			want:    []models.NodeTaint{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeNodeTaints(tt.current, tt.set, tt.remove)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeNodeTaints() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateNodeKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		wantErr bool
+	}{
+		{key: "gpu"},
+		{key: "nvidia.com/gpu.product"},
+		{key: "example.com/team"},
+		{key: "notkubernetes.io/team"},
+		{key: "", wantErr: true},
+		{key: "-gpu", wantErr: true},
+		{key: "example.com/", wantErr: true},
+		{key: "a/b/c", wantErr: true},
+		{key: "kubernetes.io/hostname", wantErr: true},
+		{key: "node-role.kubernetes.io/worker", wantErr: true},
+		{key: "k8s.io/team", wantErr: true},
+		{key: "topology.k8s.io/zone", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			err := validateNodeKey(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateNodeKey(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -9,7 +9,8 @@ import (
 )
 
 // ReadNodes returns nodes as JSON
-// @Description Get nodes
+// @Description Get nodes with the requests and limits of their non-terminated pods as a percentage of allocatable,
+// @Description and their current CPU and memory usage when the metrics API is installed
 // @Summary Get nodes
 // @Tags Nodes
 // @Produce json
@@ -31,11 +32,25 @@ func (h Handlers) ReadNodes(c *fiber.Ctx) error {
 	if len(nodes) == 0 {
 		return c.SendStatus(fiber.StatusNoContent)
 	}
+
+	if pods := h.listPodsByNode(); pods != nil {
+		for i := range nodes {
+			nodes[i].Allocated = nodeAllocated(nodes[i].Allocatable, pods[nodes[i].Name])
+		}
+	}
+	usages := h.listNodeUsage()
+	for i := range nodes {
+		if usage, exists := usages[nodes[i].Name]; exists {
+			usage = usageOf(usage, nodes[i].Allocatable)
+			nodes[i].Usage = &usage
+		}
+	}
 	return c.JSON(nodes)
 }
 
 // ReadNodeDetail returns node detail as JSON
-// @Description Get node detail
+// @Description Get node detail with the Kueue resource flavors that cover the node, the pods on the node
+// @Description and the requests and limits of its non-terminated pods as a percentage of allocatable
 // @Summary Get node detail
 // @Tags Nodes
 // @Produce json
@@ -60,5 +75,32 @@ func (h Handlers) ReadNodeDetail(c *fiber.Ctx) error {
 		}
 		return c.Status(500).JSON(fiber.Map{"error": "Failed reading node"})
 	}
+
+	// Resource flavors whose node labels and node taints the node carries
+	var flavors []models.ResourceFlavor
+	if h.EnvClient.IsMockMode() {
+		flavors, err = h.MockClient.ListResourceFlavors()
+	} else {
+		flavors, err = h.K8sClient.ListResourceFlavors()
+	}
+	if err != nil {
+		log.Printf("failed reading resource flavors: %v", err)
+	}
+	nodeDetail.Flavors = flavorsForNode(flavors, nodeDetail.Labels, nodeDetail.Taints)
+
+	var pods []models.Pod
+	if h.EnvClient.IsMockMode() {
+		pods, err = h.MockClient.ListPodsOnNode(name)
+	} else {
+		pods, err = h.K8sClient.ListPodsOnNode(name)
+	}
+	if err != nil {
+		log.Printf("failed reading node pods: %v", err)
+	} else {
+		sortPods(pods)
+		nodeDetail.Allocated = nodeAllocated(nodeDetail.Allocatable, pods)
+		nodeDetail.Pods = pods
+	}
+
 	return c.JSON(nodeDetail)
 }
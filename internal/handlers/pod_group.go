@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ReadPodGroups returns KAI Scheduler PodGroups as JSON
+// @Description Get KAI Scheduler PodGroups with their minimum member count, the number of pods bound to a node,
+// @Description conditions and the last message of the scheduler. A gang that has fewer pods bound than minMember is waiting for the rest.
+// @Summary Get pod groups
+// @Tags PodGroups
+// @Produce json
+// @Param namespace query string false "PodGroup namespace"
+// @Param queue query string false "KAI Scheduler queue name"
+// @Success 200 {array} models.PodGroup
+// @Success 204
+// @Router /api/v1/pod-groups [get]
+func (h Handlers) ReadPodGroups(c *fiber.Ctx) error {
+	var podGroups []models.PodGroup
+	var err error
+
+	namespace := c.Query("namespace")
+	queue := c.Query("queue")
+
+	if h.EnvClient.IsMockMode() {
+		podGroups, err = h.MockClient.ListPodGroups(namespace, queue)
+	} else {
+		podGroups, err = h.K8sClient.ListPodGroups(namespace, queue)
+	}
+	if err != nil {
+		log.Printf("failed reading pod groups: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading pod groups"})
+	}
+	if len(podGroups) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+	return c.JSON(podGroups)
+}
+
+// ReadPodGroupDetail returns KAI Scheduler PodGroup detail as JSON
+// @Description Get KAI Scheduler PodGroup detail with its pods and the nodes they are bound to, conditions and scheduling conditions
+// @Description with the reasons the scheduler could not schedule the whole group
+// @Summary Get pod group detail
+// @Tags PodGroups
+// @Produce json
+// @Param namespace path string true "PodGroup namespace"
+// @Param name path string true "PodGroup name"
+// @Success 200 {object} models.PodGroup
+// @Failure 404 {object} models.Error
+// @Router /api/v1/namespaces/{namespace}/pod-groups/{name} [get]
+func (h Handlers) ReadPodGroupDetail(c *fiber.Ctx) error {
+	var podGroup *models.PodGroup
+	var err error
+
+	namespace := c.Params("namespace")
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		podGroup, err = h.MockClient.GetPodGroup(namespace, name)
+	} else {
+		podGroup, err = h.K8sClient.GetPodGroup(namespace, name)
+	}
+	if err != nil {
+		log.Printf("failed reading pod group: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Pod group not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading pod group"})
+	}
+	return c.JSON(podGroup)
+}
@@ -0,0 +1,294 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// logHeartbeatInterval is how often a followed log stream sends a comment, so a client that has gone away is noticed on a quiet pod
+const logHeartbeatInterval = 15 * time.Second
+
+// podLogStream is an open log stream of a single pod
+type podLogStream struct {
+	pod    string
+	stream io.ReadCloser
+}
+
+// mergedLogStream closes the pod streams feeding the merged stream when it is closed
+type mergedLogStream struct {
+	*io.PipeReader
+	streams []podLogStream
+}
+
+func (m *mergedLogStream) Close() error {
+	for _, s := range m.streams {
+		s.stream.Close()
+	}
+	return m.PipeReader.Close()
+}
+
+// ReadPodLogs returns the logs of a pod container
+// @Description Get the logs of a pod container as plain text, or as Server-Sent Events when following
+// @Summary Get pod logs
+// @Tags Pods
+// @Produce plain
+// @Produce text/event-stream
+// @Param namespace path string true "Pod namespace"
+// @Param name path string true "Pod name"
+// @Param container query string false "Container name, required for pods with more than one container"
+// @Param tailLines query int false "Number of lines from the end of the logs to return"
+// @Param sinceSeconds query int false "Only return logs newer than this many seconds"
+// @Param timestamps query bool false "Prefix each line with its timestamp"
+// @Param previous query bool false "Return the logs of the previous container instance"
+// @Param follow query bool false "Stream the logs as Server-Sent Events"
+// @Success 200 {string} string
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Router /api/v1/namespaces/{namespace}/pods/{name}/logs [get]
+func (h Handlers) ReadPodLogs(c *fiber.Ctx) error {
+	namespace := c.Params("namespace")
+	name := c.Params("name")
+
+	opts, err := parsePodLogOptions(c)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	stream, err := h.streamPodLogs(namespace, name, opts)
+	if err != nil {
+		log.Printf("failed reading pod logs: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Pod not found"})
+		}
+		if isBadLogRequest(err) {
+			return sendBadRequest(c, err.Error())
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading pod logs"})
+	}
+
+	return sendLogStream(c, stream, opts.Follow)
+}
+
+// ReadJobLogs returns the merged logs of all pods owned by a job
+// @Description Get the logs of all pods owned by a job, each line prefixed with the pod name, as plain text or as Server-Sent Events when following
+// @Summary Get job logs
+// @Tags Job
+// @Produce plain
+// @Produce text/event-stream
+// @Param namespace path string true "Job namespace"
+// @Param name path string true "Job name"
+// @Param container query string false "Container name"
+// @Param tailLines query int false "Number of lines from the end of each pod's logs to return"
+// @Param sinceSeconds query int false "Only return logs newer than this many seconds"
+// @Param timestamps query bool false "Prefix each line with its timestamp"
+// @Param previous query bool false "Return the logs of the previous container instances"
+// @Param follow query bool false "Stream the logs as Server-Sent Events"
+// @Success 200 {string} string
+// @Success 204
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Router /api/v1/namespaces/{namespace}/jobs/{name}/logs [get]
+func (h Handlers) ReadJobLogs(c *fiber.Ctx) error {
+	namespace := c.Params("namespace")
+	name := c.Params("name")
+
+	opts, err := parsePodLogOptions(c)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	var job *models.JobDetail
+	if h.EnvClient.IsMockMode() {
+		job, err = h.MockClient.GetJob(namespace, name)
+	} else {
+		job, err = h.K8sClient.GetJob(namespace, name)
+	}
+	if err != nil {
+		log.Printf("failed reading job: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Job not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading job"})
+	}
+
+	// Pods that have no logs yet, such as pods still pulling their image, are skipped
+	var streams []podLogStream
+	for _, p := range job.Pods {
+		stream, err := h.streamPodLogs(p.Namespace, p.Name, opts)
+		if err != nil {
+			log.Printf("skipping logs of pod %s/%s: %v", p.Namespace, p.Name, err)
+			continue
+		}
+		streams = append(streams, podLogStream{pod: p.Name, stream: stream})
+	}
+	if len(streams) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+
+	return sendLogStream(c, mergeLogStreams(streams, opts.Follow), opts.Follow)
+}
+
+func (h Handlers) streamPodLogs(namespace, name string, opts models.PodLogOptions) (io.ReadCloser, error) {
+	if h.EnvClient.IsMockMode() {
+		return h.MockClient.StreamPodLogs(namespace, name, opts)
+	}
+	return h.K8sClient.StreamPodLogs(namespace, name, opts)
+}
+
+// parsePodLogOptions reads the log options from the query string
+func parsePodLogOptions(c *fiber.Ctx) (models.PodLogOptions, error) {
+	opts := models.PodLogOptions{
+		Container:  c.Query("container"),
+		Follow:     c.QueryBool("follow"),
+		Previous:   c.QueryBool("previous"),
+		Timestamps: c.QueryBool("timestamps"),
+	}
+
+	for field, target := range map[string]**int64{"tailLines": &opts.TailLines, "sinceSeconds": &opts.SinceSeconds} {
+		if c.Query(field) == "" {
+			continue
+		}
+		value := c.QueryInt(field, -1)
+		if value < 0 {
+			return opts, fmt.Errorf("query '%s' must be a non-negative integer", field)
+		}
+		v := int64(value)
+		*target = &v
+	}
+	if opts.SinceSeconds != nil && *opts.SinceSeconds == 0 {
+		return opts, fmt.Errorf("query 'sinceSeconds' must be at least 1")
+	}
+
+	return opts, nil
+}
+
+// isBadLogRequest reports whether the logs could not be read because of the request, such as an unknown container
+func isBadLogRequest(err error) bool {
+	var fe *fiber.Error
+	if errors.As(err, &fe) {
+		return fe.Code == fiber.StatusBadRequest
+	}
+	return apierrors.IsBadRequest(err)
+}
+
+// mergeLogStreams merges pod log streams line by line, prefixing every line with the pod it came from.
+// Followed streams are interleaved as lines arrive, otherwise each pod's logs are written in turn ordered by pod name.
+func mergeLogStreams(streams []podLogStream, interleave bool) io.ReadCloser {
+	sort.Slice(streams, func(i, j int) bool {
+		return streams[i].pod < streams[j].pod
+	})
+
+	pr, pw := io.Pipe()
+	var mu sync.Mutex
+	copyLines := func(s podLogStream) error {
+		scanner := bufio.NewScanner(s.stream)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			mu.Lock()
+			_, err := fmt.Fprintf(pw, "[%s] %s\n", s.pod, scanner.Text())
+			mu.Unlock()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if interleave {
+		var wg sync.WaitGroup
+		for _, s := range streams {
+			wg.Go(func() {
+				_ = copyLines(s)
+			})
+		}
+		go func() {
+			wg.Wait()
+			pw.Close()
+		}()
+	} else {
+		go func() {
+			for _, s := range streams {
+				if err := copyLines(s); err != nil {
+					return
+				}
+			}
+			pw.Close()
+		}()
+	}
+
+	return &mergedLogStream{PipeReader: pr, streams: streams}
+}
+
+// sendLogStream writes the logs as plain text, or as Server-Sent Events with one event per line when following
+func sendLogStream(c *fiber.Ctx, stream io.ReadCloser, follow bool) error {
+	if !follow {
+		c.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)
+		// The stream is closed by fasthttp once the body has been sent
+		return c.SendStream(stream)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		// Closing the stream unblocks the scanner when the client goes away while the pod is quiet
+		defer stream.Close()
+		done := make(chan struct{})
+		defer close(done)
+
+		lines := make(chan string)
+		var scanErr error
+		go func() {
+			defer close(lines)
+			scanner := bufio.NewScanner(stream)
+			scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+			for scanner.Scan() {
+				select {
+				case lines <- scanner.Text():
+				case <-done:
+					return
+				}
+			}
+			scanErr = scanner.Err()
+		}()
+
+		heartbeat := time.NewTicker(logHeartbeatInterval)
+		defer heartbeat.Stop()
+		for {
+			select {
+			case line, ok := <-lines:
+				if !ok {
+					if scanErr != nil {
+						log.Printf("failed reading log stream: %v", scanErr)
+						fmt.Fprintf(w, "event: error\ndata: %s\n\n", scanErr)
+					}
+					fmt.Fprint(w, "event: end\ndata: \n\n")
+					_ = w.Flush()
+					return
+				}
+				fmt.Fprintf(w, "data: %s\n\n", line)
+			case <-heartbeat.C:
+				// Clients ignore SSE comments, the write only checks that the client is still there
+				fmt.Fprint(w, ": heartbeat\n\n")
+			}
+			// A failed flush means the client has gone away
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+	return nil
+}
@@ -9,7 +9,7 @@ import (
 )
 
 // ReadPods returns pods as JSON
-// @Description Get pods
+// @Description Get pods with their current CPU and memory usage as a percentage of requests when the metrics API is installed
 // @Summary Get pods
 // @Tags Pods
 // @Produce json
@@ -31,11 +31,19 @@ func (h Handlers) ReadPods(c *fiber.Ctx) error {
 	if len(pods) == 0 {
 		return c.SendStatus(fiber.StatusNoContent)
 	}
+
+	usages := h.listPodUsage()
+	for i := range pods {
+		if usage, exists := usages[pods[i].Namespace+"/"+pods[i].Name]; exists {
+			usage = usageOf(usage, pods[i].Requests)
+			pods[i].Usage = &usage
+		}
+	}
 	return c.JSON(pods)
 }
 
 // ReadPodDetail returns pod detail as JSON
-// @Description Get pod detail
+// @Description Get pod detail with the KAI Scheduler BindRequests of the pod, which show binds that failed after a node was chosen
 // @Summary Get pod detail
 // @Tags Pods
 // @Produce json
@@ -62,5 +70,16 @@ func (h Handlers) ReadPodDetail(c *fiber.Ctx) error {
 		}
 		return c.Status(500).JSON(fiber.Map{"error": "Failed reading pod"})
 	}
+
+	// Bind requests the KAI Scheduler created for the pod
+	if h.EnvClient.IsMockMode() {
+		podDetail.BindRequests, err = h.MockClient.ListBindRequests(namespace, name, "", "")
+	} else {
+		podDetail.BindRequests, err = h.K8sClient.ListBindRequests(namespace, name, "", "")
+	}
+	if err != nil {
+		log.Printf("failed reading bind requests: %v", err)
+	}
+
 	return c.JSON(podDetail)
 }
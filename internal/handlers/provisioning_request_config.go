@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"fmt"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ReadProvisioningRequestConfigs returns provisioning request configs as JSON
+// @Description Get Kueue provisioning request configs
+// @Summary Get provisioning request configs
+// @Tags AdmissionChecks
+// @Produce json
+// @Success 200 {array} models.ProvisioningRequestConfig
+// @Success 204
+// @Router /api/v1/provisioning-request-configs [get]
+func (h Handlers) ReadProvisioningRequestConfigs(c *fiber.Ctx) error {
+	var configs []models.ProvisioningRequestConfig
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		configs, err = h.MockClient.ListProvisioningRequestConfigs()
+	} else {
+		configs, err = h.K8sClient.ListProvisioningRequestConfigs()
+	}
+	if err != nil {
+		log.Printf("failed reading provisioning request configs: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading provisioning request configs"})
+	}
+	if len(configs) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+	return c.JSON(configs)
+}
+
+// ReadProvisioningRequestConfigDetail returns provisioning request config detail as JSON
+// @Description Get provisioning request config detail
+// @Summary Get provisioning request config detail
+// @Tags AdmissionChecks
+// @Produce json
+// @Param name path string true "ProvisioningRequestConfig name"
+// @Success 200 {object} models.ProvisioningRequestConfig
+// @Failure 404 {object} models.Error
+// @Router /api/v1/provisioning-request-configs/{name} [get]
+func (h Handlers) ReadProvisioningRequestConfigDetail(c *fiber.Ctx) error {
+	var config *models.ProvisioningRequestConfig
+	var err error
+
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		config, err = h.MockClient.GetProvisioningRequestConfig(name)
+	} else {
+		config, err = h.K8sClient.GetProvisioningRequestConfig(name)
+	}
+	if err != nil {
+		log.Printf("failed reading provisioning request config: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Provisioning request config not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading provisioning request config"})
+	}
+	return c.JSON(config)
+}
+
+// CreateProvisioningRequestConfig creates a new provisioning request config
+// @Description Create a new Kueue provisioning request config for provisioning admission checks
+// @Summary Create provisioning request config
+// @Tags AdmissionChecks
+// @Accept json
+// @Produce json
+// @Param config body models.ProvisioningRequestConfig true "ProvisioningRequestConfig to create"
+// @Success 201 {object} models.ProvisioningRequestConfig
+// @Failure 400 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/provisioning-request-configs [post]
+func (h Handlers) CreateProvisioningRequestConfig(c *fiber.Ctx) error {
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	config, err := validateProvisioningRequestConfigSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	var created *models.ProvisioningRequestConfig
+	if h.EnvClient.IsMockMode() {
+		created, err = h.MockClient.CreateProvisioningRequestConfig(*config)
+	} else {
+		created, err = h.K8sClient.CreateProvisioningRequestConfig(*config)
+	}
+	if err != nil {
+		log.Printf("failed creating provisioning request config: %v", err)
+		return sendClientError(c, "Provisioning request config not found", err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(created)
+}
+
+// DeleteProvisioningRequestConfig deletes a provisioning request config
+// @Description Delete a provisioning request config, admission checks that reference it become inactive
+// @Summary Delete provisioning request config
+// @Tags AdmissionChecks
+// @Produce json
+// @Param name path string true "ProvisioningRequestConfig name"
+// @Success 204
+// @Failure 404 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/provisioning-request-configs/{name} [delete]
+func (h Handlers) DeleteProvisioningRequestConfig(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var err error
+	if h.EnvClient.IsMockMode() {
+		err = h.MockClient.DeleteProvisioningRequestConfig(name)
+	} else {
+		err = h.K8sClient.DeleteProvisioningRequestConfig(name)
+	}
+	if err != nil {
+		log.Printf("failed deleting provisioning request config: %v", err)
+		return sendClientError(c, "Provisioning request config not found", err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// validateProvisioningRequestConfigSchema validates the provisioning request config creation request
+func validateProvisioningRequestConfigSchema(rawBody map[string]any) (*models.ProvisioningRequestConfig, error) {
+	err := checkAllowedFields(rawBody, "managedResources", "name", "parameters", "podSetMergePolicy", "provisioningClassName", "retryStrategy")
+	if err != nil {
+		return nil, err
+	}
+
+	config := &models.ProvisioningRequestConfig{}
+
+	if config.Name, err = requiredString(rawBody, "name"); err != nil {
+		return nil, err
+	}
+
+	if config.ProvisioningClassName, err = requiredString(rawBody, "provisioningClassName"); err != nil {
+		return nil, err
+	}
+
+	if config.Parameters, err = optionalStringMap(rawBody, "parameters"); err != nil {
+		return nil, err
+	}
+
+	if config.ManagedResources, err = optionalStringSlice(rawBody, "managedResources"); err != nil {
+		return nil, err
+	}
+
+	config.PodSetMergePolicy, err = optionalEnum(rawBody, "podSetMergePolicy", "IdenticalPodTemplates", "IdenticalWorkloadSchedulingRequirements")
+	if err != nil {
+		return nil, err
+	}
+
+	retry, err := optionalObject(rawBody, "retryStrategy")
+	if err != nil {
+		return nil, err
+	}
+	if retry != nil {
+		if err := checkAllowedFields(retry, "backoffBaseSeconds", "backoffLimitCount", "backoffMaxSeconds"); err != nil {
+			return nil, err
+		}
+		rs := &models.ProvisioningRequestRetryStrategy{}
+		if rs.BackoffLimitCount, err = optionalInt32(retry, "backoffLimitCount"); err != nil {
+			return nil, err
+		}
+		if rs.BackoffBaseSeconds, err = optionalInt32(retry, "backoffBaseSeconds"); err != nil {
+			return nil, err
+		}
+		if rs.BackoffMaxSeconds, err = optionalInt32(retry, "backoffMaxSeconds"); err != nil {
+			return nil, err
+		}
+		for _, v := range []*int32{rs.BackoffLimitCount, rs.BackoffBaseSeconds, rs.BackoffMaxSeconds} {
+			if v != nil && *v < 0 {
+				return nil, fmt.Errorf("retryStrategy fields cannot be negative")
+			}
+		}
+		config.RetryStrategy = rs
+	}
+
+	return config, nil
+}
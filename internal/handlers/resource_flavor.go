@@ -138,6 +138,107 @@ func (h Handlers) DeleteResourceFlavor(c *fiber.Ctx) error {
 	return c.SendStatus(fiber.StatusNoContent)
 }
 
+// UpdateResourceFlavor replaces the spec of a resource flavor
+// @Description Replace the node labels, node taints, tolerations and topology of a resource flavor. Kueue rejects changes once topologyName is set.
+// @Description When resourceVersion is given and the resource flavor has been modified since, 409 is returned.
+// @Summary Update resource flavor
+// @Tags ResourceFlavors
+// @Accept json
+// @Produce json
+// @Param name path string true "ResourceFlavor name"
+// @Param resourceFlavor body models.ResourceFlavor true "ResourceFlavor spec"
+// @Success 200 {object} models.ResourceFlavor
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/resource-flavors/{name} [put]
+func (h Handlers) UpdateResourceFlavor(c *fiber.Ctx) error {
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	return h.updateResourceFlavor(c, rawBody)
+}
+
+// PatchResourceFlavor applies a JSON merge patch to a resource flavor
+// @Description Apply a JSON merge patch (RFC 7386) to the spec and resourceVersion of a resource flavor, null removes a field and
+// @Description nodeTaints and tolerations are replaced as a whole. The resourceVersion read before patching is used unless the patch gives one,
+// @Description so a concurrent edit returns 409.
+// @Summary Patch resource flavor
+// @Tags ResourceFlavors
+// @Accept json
+// @Produce json
+// @Param name path string true "ResourceFlavor name"
+// @Param patch body models.ResourceFlavor true "JSON merge patch"
+// @Success 200 {object} models.ResourceFlavor
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/resource-flavors/{name} [patch]
+func (h Handlers) PatchResourceFlavor(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var patch map[string]any
+	if err := c.BodyParser(&patch); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	var current *models.ResourceFlavor
+	var err error
+	if h.EnvClient.IsMockMode() {
+		current, err = h.MockClient.GetResourceFlavor(name)
+	} else {
+		current, err = h.K8sClient.GetResourceFlavor(name)
+	}
+	if err != nil {
+		log.Printf("failed reading resource flavor: %v", err)
+		return sendClientError(c, "Resource flavor not found", err)
+	}
+
+	rawBody, err := patchModel(current, patch, "name", "nodeLabels", "nodeTaints", "resourceVersion", "tolerations", "topologyName")
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	return h.updateResourceFlavor(c, rawBody)
+}
+
+// updateResourceFlavor validates a full resource flavor and replaces the spec of the resource flavor in the path with it
+func (h Handlers) updateResourceFlavor(c *fiber.Ctx, rawBody map[string]any) error {
+	name := c.Params("name")
+
+	if _, exists := rawBody["name"]; !exists {
+		rawBody["name"] = name
+	}
+
+	rf, err := validateResourceFlavorSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+	if rf.Name != name {
+		return sendBadRequest(c, "field 'name' cannot be changed")
+	}
+	if rf.ResourceVersion, err = optionalString(rawBody, "resourceVersion"); err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	var updated *models.ResourceFlavor
+	if h.EnvClient.IsMockMode() {
+		updated, err = h.MockClient.UpdateResourceFlavor(*rf)
+	} else {
+		updated, err = h.K8sClient.UpdateResourceFlavor(*rf)
+	}
+	if err != nil {
+		log.Printf("failed updating resource flavor: %v", err)
+		return sendClientError(c, "Resource flavor not found", err)
+	}
+
+	return c.JSON(updated)
+}
+
 //revive:disable:cyclomatic
 func validateResourceFlavorSchema(rawBody map[string]any) (*models.ResourceFlavor, error) {
 	nameValue, exists := rawBody["name"]
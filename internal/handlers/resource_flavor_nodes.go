@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ReadResourceFlavorNodes returns the nodes a resource flavor covers as JSON
+// @Description Get the nodes covered by a resource flavor, the nodes carrying all of its node labels and node taints,
+// @Description with their total (capacity) and allocatable CPU, memory and GPUs summed over the matched set.
+// @Description A flavor that matches no node is flagged with an error, workloads assigned to it could never be scheduled.
+// @Summary Get resource flavor nodes
+// @Tags ResourceFlavors
+// @Produce json
+// @Param name path string true "ResourceFlavor name"
+// @Success 200 {object} models.ResourceFlavorNodes
+// @Failure 404 {object} models.Error
+// @Router /api/v1/resource-flavors/{name}/nodes [get]
+func (h Handlers) ReadResourceFlavorNodes(c *fiber.Ctx) error {
+	var rf *models.ResourceFlavor
+	var nodes []models.Node
+	var err error
+
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		rf, err = h.MockClient.GetResourceFlavor(name)
+	} else {
+		rf, err = h.K8sClient.GetResourceFlavor(name)
+	}
+	if err != nil {
+		log.Printf("failed reading resource flavor: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Resource flavor not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading resource flavor"})
+	}
+
+	if h.EnvClient.IsMockMode() {
+		nodes, err = h.MockClient.ListNodes()
+	} else {
+		nodes, err = h.K8sClient.ListNodes()
+	}
+	if err != nil {
+		log.Printf("failed reading nodes: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading nodes"})
+	}
+
+	return c.JSON(buildFlavorNodes(*rf, nodes))
+}
+
+// flavorsForNode returns the names of the resource flavors that cover a node, sorted by name
+func flavorsForNode(flavors []models.ResourceFlavor, labels map[string]string, taints []models.NodeTaint) []string {
+	var result []string
+	for _, rf := range flavors {
+		if flavorCoversNode(rf, labels, taints) {
+			result = append(result, rf.Name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// flavorCoversNode reports whether a node carries every node label and node taint of a flavor, as Kueue requires when it assigns the flavor
+func flavorCoversNode(rf models.ResourceFlavor, labels map[string]string, taints []models.NodeTaint) bool {
+	for key, value := range rf.NodeLabels {
+		if v, exists := labels[key]; !exists || v != value {
+			return false
+		}
+	}
+	for _, ft := range rf.NodeTaints {
+		carried := slices.ContainsFunc(taints, func(t models.NodeTaint) bool {
+			return t.Key == ft.Key && t.Value == ft.Value && t.Effect == ft.Effect
+		})
+		if !carried {
+			return false
+		}
+	}
+	return true
+}
+
+// buildFlavorNodes lists the nodes covered by a flavor and sums their CPU, memory and GPUs
+func buildFlavorNodes(rf models.ResourceFlavor, nodes []models.Node) models.ResourceFlavorNodes {
+	result := models.ResourceFlavorNodes{
+		Flavor: rf.Name,
+		Nodes:  []models.ResourceFlavorNode{},
+	}
+
+	allocatable := make(map[string]resource.Quantity)
+	capacity := make(map[string]resource.Quantity)
+
+	for _, n := range nodes {
+		if !flavorCoversNode(rf, n.Labels, n.Taints) {
+			continue
+		}
+
+		nodeAllocatable := schedulableResources(n.Allocatable)
+		nodeCapacity := schedulableResources(n.Capacity)
+		addQuantities(allocatable, nodeAllocatable)
+		addQuantities(capacity, nodeCapacity)
+
+		result.Nodes = append(result.Nodes, models.ResourceFlavorNode{
+			Allocatable: toNodeResources(nodeAllocatable),
+			Capacity:    toNodeResources(nodeCapacity),
+			Name:        n.Name,
+			Ready:       n.Ready,
+		})
+	}
+
+	sort.Slice(result.Nodes, func(i, j int) bool { return result.Nodes[i].Name < result.Nodes[j].Name })
+	result.Allocatable = toNodeResources(allocatable)
+	result.Capacity = toNodeResources(capacity)
+
+	if len(result.Nodes) == 0 {
+		result.Error = fmt.Sprintf("resource flavor %s matches no nodes", rf.Name)
+	}
+	return result
+}
+
+// schedulableResources keeps the CPU, memory and GPUs of a node's resources
+func schedulableResources(resources models.NodeResources) map[string]resource.Quantity {
+	result := make(map[string]resource.Quantity)
+	for name, value := range resources {
+		if name != "cpu" && name != "memory" && !isGPUResource(name) {
+			continue
+		}
+		if q, err := resource.ParseQuantity(value); err == nil {
+			result[name] = q
+		}
+	}
+	return result
+}
+
+// isGPUResource reports whether an extended resource is a GPU, as exposed by the NVIDIA, AMD and Intel device plugins
+func isGPUResource(name string) bool {
+	return strings.HasSuffix(name, "/gpu") || strings.HasPrefix(name, "gpu.intel.com/")
+}
@@ -0,0 +1,304 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// checkAllowedFields rejects any field that is not part of the schema
+func checkAllowedFields(rawBody map[string]any, allowed ...string) error {
+	var unknown []string
+	for field := range rawBody {
+		if !slices.Contains(allowed, field) {
+			unknown = append(unknown, field)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown field '%s'", unknown[0])
+	}
+	return nil
+}
+
+// requiredString returns a non-empty string field
+func requiredString(rawBody map[string]any, field string) (string, error) {
+	value, exists := rawBody[field]
+	if !exists {
+		return "", fmt.Errorf("field '%s' is required", field)
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("field '%s' must be a string", field)
+	}
+
+	if s == "" {
+		return "", fmt.Errorf("field '%s' cannot be empty", field)
+	}
+
+	return s, nil
+}
+
+// optionalString returns a string field, or an empty string when it is absent
+func optionalString(rawBody map[string]any, field string) (string, error) {
+	value, exists := rawBody[field]
+	if !exists {
+		return "", nil
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("field '%s' must be a string", field)
+	}
+
+	return s, nil
+}
+
+// optionalStringSlice returns an array of strings field, or nil when it is absent
+func optionalStringSlice(rawBody map[string]any, field string) ([]string, error) {
+	value, exists := rawBody[field]
+	if !exists {
+		return nil, nil
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("field '%s' must be an array", field)
+	}
+
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		s, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s items must be strings", field)
+		}
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+// optionalStringMap returns an object of strings field, or nil when it is absent
+func optionalStringMap(rawBody map[string]any, field string) (map[string]string, error) {
+	value, exists := rawBody[field]
+	if !exists {
+		return nil, nil
+	}
+
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("field '%s' must be an object", field)
+	}
+
+	result := make(map[string]string, len(obj))
+	for k, v := range obj {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s values must be strings", field)
+		}
+		result[k] = s
+	}
+
+	return result, nil
+}
+
+// optionalInt32 returns a whole number field, or nil when it is absent
+func optionalInt32(rawBody map[string]any, field string) (*int32, error) {
+	value, exists := rawBody[field]
+	if !exists {
+		return nil, nil
+	}
+
+	f, ok := value.(float64)
+	if !ok || f != math.Trunc(f) || f < math.MinInt32 || f > math.MaxInt32 {
+		return nil, fmt.Errorf("field '%s' must be an integer", field)
+	}
+
+	i := int32(f)
+	return &i, nil
+}
+
+// optionalNumber returns a number field, or nil when it is absent
+func optionalNumber(rawBody map[string]any, field string) (*float64, error) {
+	value, exists := rawBody[field]
+	if !exists {
+		return nil, nil
+	}
+
+	f, ok := value.(float64)
+	if !ok {
+		return nil, fmt.Errorf("field '%s' must be a number", field)
+	}
+
+	return &f, nil
+}
+
+// optionalBool returns a boolean field, or false when it is absent
+func optionalBool(rawBody map[string]any, field string) (bool, error) {
+	value, exists := rawBody[field]
+	if !exists {
+		return false, nil
+	}
+
+	b, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("field '%s' must be a boolean", field)
+	}
+
+	return b, nil
+}
+
+// optionalQuantityMap returns an object of resource quantities field, or nil when it is absent.
+// Quantities may be given as strings ("500m", "4Gi") or as plain numbers.
+func optionalQuantityMap(rawBody map[string]any, field string) (map[string]string, error) {
+	value, exists := rawBody[field]
+	if !exists {
+		return nil, nil
+	}
+
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("field '%s' must be an object", field)
+	}
+
+	result := make(map[string]string, len(obj))
+	for name, v := range obj {
+		q, err := toQuantity(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s value for '%s' must be a resource quantity", field, name)
+		}
+		result[name] = q.String()
+	}
+
+	return result, nil
+}
+
+// optionalQuantity returns a resource quantity field in canonical form, or an empty string when it is absent
+func optionalQuantity(rawBody map[string]any, field string) (string, error) {
+	value, exists := rawBody[field]
+	if !exists {
+		return "", nil
+	}
+
+	q, err := toQuantity(value)
+	if err != nil {
+		return "", fmt.Errorf("field '%s' must be a resource quantity", field)
+	}
+	return q.String(), nil
+}
+
+// optionalEnum returns a string field that must be one of the allowed values, or an empty string when it is absent
+func optionalEnum(rawBody map[string]any, field string, allowed ...string) (string, error) {
+	s, err := optionalString(rawBody, field)
+	if err != nil || s == "" {
+		return s, err
+	}
+	if !slices.Contains(allowed, s) {
+		return "", fmt.Errorf("field '%s' must be one of %s", field, strings.Join(allowed, ", "))
+	}
+	return s, nil
+}
+
+// optionalObject returns an object field, or nil when it is absent
+func optionalObject(rawBody map[string]any, field string) (map[string]any, error) {
+	value, exists := rawBody[field]
+	if !exists {
+		return nil, nil
+	}
+
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("field '%s' must be an object", field)
+	}
+	return obj, nil
+}
+
+// optionalObjectSlice returns an array of objects field, or nil when it is absent
+func optionalObjectSlice(rawBody map[string]any, field string) ([]map[string]any, error) {
+	value, exists := rawBody[field]
+	if !exists {
+		return nil, nil
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("field '%s' must be an array", field)
+	}
+
+	result := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s items must be objects", field)
+		}
+		result = append(result, obj)
+	}
+	return result, nil
+}
+
+// toQuantity parses a resource quantity given as a string ("500m", "4Gi") or as a plain number
+func toQuantity(v any) (resource.Quantity, error) {
+	switch t := v.(type) {
+	case string:
+		return resource.ParseQuantity(t)
+	case float64:
+		return resource.ParseQuantity(strconv.FormatFloat(t, 'f', -1, 64))
+	default:
+		return resource.Quantity{}, fmt.Errorf("unsupported type %T", v)
+	}
+}
+
+// mergePatch applies a JSON merge patch (RFC 7386) to a decoded JSON document, null removes a field and arrays are replaced as a whole
+func mergePatch(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = map[string]any{}
+	}
+
+	result := maps.Clone(t)
+	for field, value := range p {
+		if value == nil {
+			delete(result, field)
+			continue
+		}
+		result[field] = mergePatch(result[field], value)
+	}
+	return result
+}
+
+// patchModel returns the given fields of a model with a JSON merge patch applied, the patch may only change those fields
+func patchModel(current any, patch map[string]any, fields ...string) (map[string]any, error) {
+	if err := checkAllowedFields(patch, fields...); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	for field := range doc {
+		if !slices.Contains(fields, field) {
+			delete(doc, field)
+		}
+	}
+
+	return mergePatch(doc, patch).(map[string]any), nil
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+	"reflect"
+	"testing"
+)
+
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		target any
+		patch  any
+		want   any
+	}{
+		{
+			name:   "new field is added",
+			target: map[string]any{"a": "1"},
+			patch:  map[string]any{"b": "2"},
+			want:   map[string]any{"a": "1", "b": "2"},
+		},
+		{
+			name:   "null removes the field",
+			target: map[string]any{"a": "1", "b": "2"},
+			patch:  map[string]any{"b": nil},
+			want:   map[string]any{"a": "1"},
+		},
+		{
+			name:   "null of a missing field is ignored",
+			target: map[string]any{"a": "1"},
+			patch:  map[string]any{"b": nil},
+			want:   map[string]any{"a": "1"},
+		},
+		{
+			name:   "nested objects are merged",
+			target: map[string]any{"a": map[string]any{"x": "1", "y": "2"}},
+			patch:  map[string]any{"a": map[string]any{"y": "3", "z": "4"}},
+			want:   map[string]any{"a": map[string]any{"x": "1", "y": "3", "z": "4"}},
+		},
+		{
+			name:   "null removes a nested field",
+			target: map[string]any{"a": map[string]any{"x": "1", "y": "2"}},
+			patch:  map[string]any{"a": map[string]any{"x": nil}},
+			want:   map[string]any{"a": map[string]any{"y": "2"}},
+		},
+		{
+			name:   "arrays are replaced",
+			target: map[string]any{"a": []any{"1", "2"}},
+			patch:  map[string]any{"a": []any{"3"}},
+			want:   map[string]any{"a": []any{"3"}},
+		},
+		{
+			name:   "object replaces a value",
+			target: map[string]any{"a": "1"},
+			patch:  map[string]any{"a": map[string]any{"x": "1"}},
+			want:   map[string]any{"a": map[string]any{"x": "1"}},
+		},
+		{
+			name:   "value replaces an object",
+			target: map[string]any{"a": map[string]any{"x": "1"}},
+			patch:  map[string]any{"a": "1"},
+			want:   map[string]any{"a": "1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergePatch(tt.target, tt.patch); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergePatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergePatchKeepsTarget(t *testing.T) {
+	target := map[string]any{"a": "1", "b": map[string]any{"x": "1"}}
+	mergePatch(target, map[string]any{"a": nil, "b": map[string]any{"x": "2"}})
+
+	want := map[string]any{"a": "1", "b": map[string]any{"x": "1"}}
+	if !reflect.DeepEqual(target, want) {
+		t.Errorf("mergePatch() changed the target to %v, want %v", target, want)
+	}
+}
+
+func TestPatchModel(t *testing.T) {
+	current := models.ClusterQueue{
+		Cohort:           "team",
+		Name:             "queue",
+		Preemption:       &models.ClusterQueuePreemption{ReclaimWithinCohort: "Any", WithinClusterQueue: "Never"},
+		QueueingStrategy: "BestEffortFIFO",
+	}
+	fields := []string{"cohort", "preemption", "queueingStrategy"}
+
+	tests := []struct {
+		name    string
+		patch   map[string]any
+		want    map[string]any
+		wantErr bool
+	}{
+		{
+			name:  "empty patch returns the given fields",
+			patch: map[string]any{},
+			want: map[string]any{
+				"cohort":           "team",
+				"preemption":       map[string]any{"reclaimWithinCohort": "Any", "withinClusterQueue": "Never"},
+				"queueingStrategy": "BestEffortFIFO",
+			},
+		},
+		{
+			name:  "null removes a field",
+			patch: map[string]any{"cohort": nil},
+			want: map[string]any{
+				"preemption":       map[string]any{"reclaimWithinCohort": "Any", "withinClusterQueue": "Never"},
+				"queueingStrategy": "BestEffortFIFO",
+			},
+		},
+		{
+			name:  "nested field is merged",
+			patch: map[string]any{"preemption": map[string]any{"withinClusterQueue": "LowerPriority"}},
+			want: map[string]any{
+				"cohort":           "team",
+				"preemption":       map[string]any{"reclaimWithinCohort": "Any", "withinClusterQueue": "LowerPriority"},
+				"queueingStrategy": "BestEffortFIFO",
+			},
+		},
+		{
+			name:    "field that may not be patched",
+			patch:   map[string]any{"name": "other"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := patchModel(current, tt.patch, fields...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("patchModel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("patchModel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
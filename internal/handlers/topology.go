@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/gofiber/fiber/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ReadTopologies returns topologies as JSON
+// @Description Get Kueue topologies
+// @Summary Get topologies
+// @Tags Topologies
+// @Produce json
+// @Success 200 {array} models.Topology
+// @Success 204
+// @Router /api/v1/topologies [get]
+func (h Handlers) ReadTopologies(c *fiber.Ctx) error {
+	var topologies []models.Topology
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		topologies, err = h.MockClient.ListTopologies()
+	} else {
+		topologies, err = h.K8sClient.ListTopologies()
+	}
+	if err != nil {
+		log.Printf("failed reading topologies: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading topologies"})
+	}
+	if len(topologies) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+	return c.JSON(topologies)
+}
+
+// ReadTopologyDetail returns topology detail as JSON
+// @Description Get topology detail
+// @Summary Get topology detail
+// @Tags Topologies
+// @Produce json
+// @Param name path string true "Topology name"
+// @Success 200 {object} models.Topology
+// @Failure 404 {object} models.Error
+// @Router /api/v1/topologies/{name} [get]
+func (h Handlers) ReadTopologyDetail(c *fiber.Ctx) error {
+	var topology *models.Topology
+	var err error
+
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		topology, err = h.MockClient.GetTopology(name)
+	} else {
+		topology, err = h.K8sClient.GetTopology(name)
+	}
+	if err != nil {
+		log.Printf("failed reading topology: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Topology not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading topology"})
+	}
+	return c.JSON(topology)
+}
+
+// ReadTopologyDomains returns the nodes of the flavors using a topology grouped into its domains as JSON
+// @Description Get, for every ResourceFlavor that references the topology, its matching nodes grouped into the topology levels
+// @Description with allocatable, requested and free capacity per domain. Only ready nodes that carry every level label are counted.
+// @Summary Get topology domains
+// @Tags Topologies
+// @Produce json
+// @Param name path string true "Topology name"
+// @Param flavor query string false "ResourceFlavor name"
+// @Success 200 {array} models.FlavorTopology
+// @Success 204
+// @Failure 404 {object} models.Error
+// @Router /api/v1/topologies/{name}/domains [get]
+func (h Handlers) ReadTopologyDomains(c *fiber.Ctx) error {
+	var topology *models.Topology
+	var flavors []models.ResourceFlavor
+	var nodes []models.Node
+	var pods []models.Pod
+	var err error
+
+	name := c.Params("name")
+	flavor := c.Query("flavor")
+
+	if h.EnvClient.IsMockMode() {
+		topology, err = h.MockClient.GetTopology(name)
+	} else {
+		topology, err = h.K8sClient.GetTopology(name)
+	}
+	if err != nil {
+		log.Printf("failed reading topology: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Topology not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading topology"})
+	}
+
+	if h.EnvClient.IsMockMode() {
+		if flavors, err = h.MockClient.ListResourceFlavors(); err == nil {
+			if nodes, err = h.MockClient.ListNodes(); err == nil {
+				pods, err = h.MockClient.ListPods()
+			}
+		}
+	} else {
+		if flavors, err = h.K8sClient.ListResourceFlavors(); err == nil {
+			if nodes, err = h.K8sClient.ListNodes(); err == nil {
+				pods, err = h.K8sClient.ListPods()
+			}
+		}
+	}
+	if err != nil {
+		log.Printf("failed reading topology domains: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading topology domains"})
+	}
+
+	var result []models.FlavorTopology
+	for _, rf := range flavors {
+		if rf.TopologyName != topology.Name || (flavor != "" && rf.Name != flavor) {
+			continue
+		}
+		result = append(result, buildFlavorTopology(*topology, rf, nodes, pods))
+	}
+	if len(result) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+	return c.JSON(result)
+}
+
+// CreateTopology creates a new topology
+// @Description Create a new Kueue topology. Levels are node labels ordered from the widest domain to the narrowest, such as block, rack and hostname.
+// @Description Levels cannot be changed once the topology is created.
+// @Summary Create topology
+// @Tags Topologies
+// @Accept json
+// @Produce json
+// @Param topology body models.Topology true "Topology to create"
+// @Success 201 {object} models.Topology
+// @Failure 400 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/topologies [post]
+func (h Handlers) CreateTopology(c *fiber.Ctx) error {
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	topology, err := validateTopologySchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	var created *models.Topology
+	if h.EnvClient.IsMockMode() {
+		created, err = h.MockClient.CreateTopology(*topology)
+	} else {
+		created, err = h.K8sClient.CreateTopology(*topology)
+	}
+	if err != nil {
+		log.Printf("failed creating topology: %v", err)
+		return sendClientError(c, "Topology not found", err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(created)
+}
+
+// DeleteTopology deletes a topology
+// @Description Delete a topology
+// @Summary Delete topology
+// @Tags Topologies
+// @Produce json
+// @Param name path string true "Topology name"
+// @Success 204
+// @Failure 404 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/topologies/{name} [delete]
+func (h Handlers) DeleteTopology(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var err error
+	if h.EnvClient.IsMockMode() {
+		err = h.MockClient.DeleteTopology(name)
+	} else {
+		err = h.K8sClient.DeleteTopology(name)
+	}
+	if err != nil {
+		log.Printf("failed deleting topology: %v", err)
+		return sendClientError(c, "Topology not found", err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// validateTopologySchema validates the topology creation request, Kueue allows up to 16 distinct levels with the hostname only as the last one
+func validateTopologySchema(rawBody map[string]any) (*models.Topology, error) {
+	err := checkAllowedFields(rawBody, "levels", "name")
+	if err != nil {
+		return nil, err
+	}
+
+	topology := &models.Topology{}
+
+	if topology.Name, err = requiredString(rawBody, "name"); err != nil {
+		return nil, err
+	}
+
+	if topology.Levels, err = optionalStringSlice(rawBody, "levels"); err != nil {
+		return nil, err
+	}
+	if len(topology.Levels) == 0 || len(topology.Levels) > 16 {
+		return nil, fmt.Errorf("field 'levels' must have between 1 and 16 items")
+	}
+
+	for i, level := range topology.Levels {
+		if level == "" {
+			return nil, fmt.Errorf("levels items cannot be empty")
+		}
+		if slices.Contains(topology.Levels[:i], level) {
+			return nil, fmt.Errorf("level '%s' is listed more than once", level)
+		}
+		if level == corev1.LabelHostname && i != len(topology.Levels)-1 {
+			return nil, fmt.Errorf("level '%s' must be the last level", corev1.LabelHostname)
+		}
+	}
+
+	return topology, nil
+}
@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// domainNode is a topology domain while the nodes of a flavor are grouped into it
+type domainNode struct {
+	allocatable map[string]resource.Quantity
+	children    map[string]*domainNode
+	nodes       int
+	requested   map[string]resource.Quantity
+}
+
+func newDomainNode() *domainNode {
+	return &domainNode{
+		allocatable: make(map[string]resource.Quantity),
+		children:    make(map[string]*domainNode),
+		requested:   make(map[string]resource.Quantity),
+	}
+}
+
+// add sums the capacity of a node into every domain along its path
+func (d *domainNode) add(path []string, allocatable, requested map[string]resource.Quantity) {
+	d.nodes++
+	addQuantities(d.allocatable, allocatable)
+	addQuantities(d.requested, requested)
+
+	if len(path) == 0 {
+		return
+	}
+	child := d.children[path[0]]
+	if child == nil {
+		child = newDomainNode()
+		d.children[path[0]] = child
+	}
+	child.add(path[1:], allocatable, requested)
+}
+
+// toModels returns the child domains ordered by value, levels holds the level of the children and those below them
+func (d *domainNode) toModels(levels []string) []models.TopologyDomain {
+	if len(levels) == 0 {
+		return nil
+	}
+
+	var result []models.TopologyDomain
+	for value, child := range d.children {
+		result = append(result, models.TopologyDomain{
+			Allocatable: toNodeResources(child.allocatable),
+			Domains:     child.toModels(levels[1:]),
+			Free:        freeNodeResources(child.allocatable, child.requested),
+			Level:       levels[0],
+			Nodes:       child.nodes,
+			Requested:   toNodeResources(child.requested),
+			Value:       value,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Value < result[j].Value
+	})
+	return result
+}
+
+// podRequestsByNode sums the requests of the pods that hold resources on each node, finished pods are left out.
+// The number of pods is added as the "pods" resource so it can be compared with the allocatable pod count.
+func podRequestsByNode(pods []models.Pod) map[string]map[string]resource.Quantity {
+	result := make(map[string]map[string]resource.Quantity)
+	for _, p := range pods {
+		if p.Node == "" || p.Status == "Succeeded" || p.Status == "Failed" {
+			continue
+		}
+		if result[p.Node] == nil {
+			result[p.Node] = make(map[string]resource.Quantity)
+		}
+		requests := result[p.Node]
+		for name, value := range p.Requests {
+			if q, err := resource.ParseQuantity(value); err == nil {
+				total := requests[name]
+				total.Add(q)
+				requests[name] = total
+			}
+		}
+		count := requests["pods"]
+		count.Add(resource.MustParse("1"))
+		requests["pods"] = count
+	}
+	return result
+}
+
+// buildFlavorTopology groups the ready nodes matched by a flavor into the domains of its topology.
+// Matched nodes that are not ready or lack a level label cannot be used by topology-aware scheduling and are listed apart.
+func buildFlavorTopology(topology models.Topology, rf models.ResourceFlavor, nodes []models.Node, pods []models.Pod) models.FlavorTopology {
+	result := models.FlavorTopology{
+		Flavor:   rf.Name,
+		Levels:   topology.Levels,
+		Topology: topology.Name,
+	}
+
+	requested := podRequestsByNode(pods)
+	root := newDomainNode()
+
+	for _, n := range nodes {
+		if !flavorCoversNode(rf, n.Labels, n.Taints) {
+			continue
+		}
+
+		var path []string
+		for _, level := range topology.Levels {
+			value, exists := n.Labels[level]
+			if !exists {
+				break
+			}
+			path = append(path, value)
+		}
+		if len(path) < len(topology.Levels) {
+			result.UnlabelledNodes = append(result.UnlabelledNodes, n.Name)
+			continue
+		}
+		if !n.Ready {
+			result.NotReadyNodes = append(result.NotReadyNodes, n.Name)
+			continue
+		}
+
+		allocatable := make(map[string]resource.Quantity, len(n.Allocatable))
+		for name, value := range n.Allocatable {
+			if q, err := resource.ParseQuantity(value); err == nil {
+				allocatable[name] = q
+			}
+		}
+		root.add(path, allocatable, requested[n.Name])
+	}
+
+	sort.Strings(result.UnlabelledNodes)
+	sort.Strings(result.NotReadyNodes)
+	result.Domains = root.toModels(topology.Levels)
+	return result
+}
+
+func addQuantities(totals, quantities map[string]resource.Quantity) {
+	for name, q := range quantities {
+		total := totals[name]
+		total.Add(q)
+		totals[name] = total
+	}
+}
+
+func toNodeResources(quantities map[string]resource.Quantity) models.NodeResources {
+	result := make(models.NodeResources, len(quantities))
+	for name, q := range quantities {
+		result[name] = q.String()
+	}
+	return result
+}
+
+// freeNodeResources returns what is left of each allocatable resource once the requests are taken out, never less than zero
+func freeNodeResources(allocatable, requested map[string]resource.Quantity) models.NodeResources {
+	result := make(models.NodeResources, len(allocatable))
+	for name, q := range allocatable {
+		free := q.DeepCopy()
+		if r, exists := requested[name]; exists {
+			free.Sub(r)
+		}
+		if free.Sign() < 0 {
+			free = resource.MustParse("0")
+		}
+		result[name] = free.String()
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"log"
+	"sort"
+
+	"github.com/gofiber/fiber/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ReadWorkloads returns Kueue workloads as JSON
+// @Description Get Kueue workloads with their pod sets, assigned flavors, admission checks and condition history.
+// @Description Workloads that are not admitted yet match the ClusterQueue of their LocalQueue.
+// @Description Workloads are sorted by effective priority, highest first, then oldest first as Kueue queues them.
+// @Description Workloads dispatched by MultiKueue report the worker cluster they were admitted on as clusterName.
+// @Summary Get workloads
+// @Tags Workloads
+// @Produce json
+// @Param namespace query string false "Workload namespace"
+// @Param localQueue query string false "Kueue LocalQueue name"
+// @Param clusterQueue query string false "Kueue ClusterQueue name"
+// @Param state query string false "Workload state (Pending, QuotaReserved, Admitted, Evicted, Finished)"
+// @Param cluster query string false "MultiKueue worker cluster the workload was dispatched to"
+// @Success 200 {array} models.Workload
+// @Success 204
+// @Router /api/v1/workloads [get]
+func (h Handlers) ReadWorkloads(c *fiber.Ctx) error {
+	var workloads []models.Workload
+	var err error
+
+	namespace := c.Query("namespace")
+	localQueue := c.Query("localQueue")
+	clusterQueue := c.Query("clusterQueue")
+	state := c.Query("state")
+	cluster := c.Query("cluster")
+
+	if h.EnvClient.IsMockMode() {
+		workloads, err = h.MockClient.ListWorkloads(namespace, localQueue, clusterQueue, state, cluster)
+	} else {
+		workloads, err = h.K8sClient.ListWorkloads(namespace, localQueue, clusterQueue, state, cluster)
+	}
+	if err != nil {
+		log.Printf("failed reading workloads: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading workloads"})
+	}
+	if len(workloads) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+
+	sortWorkloadsByPriority(workloads)
+	return c.JSON(workloads)
+}
+
+// ReadWorkloadDetail returns Kueue workload detail as JSON
+// @Description Get Kueue workload detail with its pod sets, assigned flavors, admission checks, requeue state and condition history
+// @Summary Get workload detail
+// @Tags Workloads
+// @Produce json
+// @Param namespace path string true "Workload namespace"
+// @Param name path string true "Workload name"
+// @Success 200 {object} models.Workload
+// @Failure 404 {object} models.Error
+// @Router /api/v1/namespaces/{namespace}/workloads/{name} [get]
+func (h Handlers) ReadWorkloadDetail(c *fiber.Ctx) error {
+	var workload *models.Workload
+	var err error
+
+	namespace := c.Params("namespace")
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		workload, err = h.MockClient.GetWorkload(namespace, name)
+	} else {
+		workload, err = h.K8sClient.GetWorkload(namespace, name)
+	}
+	if err != nil {
+		log.Printf("failed reading workload: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Workload not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading workload"})
+	}
+	return c.JSON(workload)
+}
+
+// sortWorkloadsByPriority orders workloads by effective priority, highest first, and then by creation time.
+// Workloads without a priority have the default priority of 0.
+func sortWorkloadsByPriority(workloads []models.Workload) {
+	priority := func(wl models.Workload) int32 {
+		if wl.Priority == nil {
+			return 0
+		}
+		return *wl.Priority
+	}
+
+	sort.SliceStable(workloads, func(i, j int) bool {
+		pi, pj := priority(workloads[i]), priority(workloads[j])
+		if pi != pj {
+			return pi > pj
+		}
+		if workloads[i].CreationTimestamp != workloads[j].CreationTimestamp {
+			return workloads[i].CreationTimestamp < workloads[j].CreationTimestamp
+		}
+		return workloads[i].Name < workloads[j].Name
+	})
+}
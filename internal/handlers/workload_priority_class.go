@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"cmyk/internal/models"
+
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/gofiber/fiber/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ReadWorkloadPriorityClasses returns workload priority classes as JSON
+// @Description Get Kueue workload priority classes, highest value first
+// @Summary Get workload priority classes
+// @Tags Workload Priority Classes
+// @Produce json
+// @Success 200 {array} models.WorkloadPriorityClass
+// @Success 204
+// @Router /api/v1/workload-priority-classes [get]
+func (h Handlers) ReadWorkloadPriorityClasses(c *fiber.Ctx) error {
+	var classes []models.WorkloadPriorityClass
+	var err error
+
+	if h.EnvClient.IsMockMode() {
+		classes, err = h.MockClient.ListWorkloadPriorityClasses()
+	} else {
+		classes, err = h.K8sClient.ListWorkloadPriorityClasses()
+	}
+	if err != nil {
+		log.Printf("failed reading workload priority classes: %v", err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading workload priority classes"})
+	}
+	if len(classes) == 0 {
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+
+	sort.SliceStable(classes, func(i, j int) bool {
+		return classes[i].Value > classes[j].Value
+	})
+	return c.JSON(classes)
+}
+
+// ReadWorkloadPriorityClassDetail returns workload priority class detail as JSON
+// @Description Get workload priority class detail
+// @Summary Get workload priority class detail
+// @Tags Workload Priority Classes
+// @Produce json
+// @Param name path string true "Workload priority class name"
+// @Success 200 {object} models.WorkloadPriorityClass
+// @Failure 404 {object} models.Error
+// @Router /api/v1/workload-priority-classes/{name} [get]
+func (h Handlers) ReadWorkloadPriorityClassDetail(c *fiber.Ctx) error {
+	var class *models.WorkloadPriorityClass
+	var err error
+
+	name := c.Params("name")
+
+	if h.EnvClient.IsMockMode() {
+		class, err = h.MockClient.GetWorkloadPriorityClass(name)
+	} else {
+		class, err = h.K8sClient.GetWorkloadPriorityClass(name)
+	}
+	if err != nil {
+		log.Printf("failed reading workload priority class: %v", err)
+		if err == fiber.ErrNotFound || apierrors.IsNotFound(err) {
+			return c.Status(404).JSON(fiber.Map{"error": "Workload priority class not found"})
+		}
+		return c.Status(500).JSON(fiber.Map{"error": "Failed reading workload priority class"})
+	}
+	return c.JSON(class)
+}
+
+// CreateWorkloadPriorityClass creates a new workload priority class
+// @Description Create a new Kueue workload priority class. Jobs reference it by name through the priorityClass field.
+// @Summary Create workload priority class
+// @Tags Workload Priority Classes
+// @Accept json
+// @Produce json
+// @Param class body models.WorkloadPriorityClass true "Workload priority class to create"
+// @Success 201 {object} models.WorkloadPriorityClass
+// @Failure 400 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/workload-priority-classes [post]
+func (h Handlers) CreateWorkloadPriorityClass(c *fiber.Ctx) error {
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	class, err := validateWorkloadPriorityClassSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+
+	var created *models.WorkloadPriorityClass
+	if h.EnvClient.IsMockMode() {
+		created, err = h.MockClient.CreateWorkloadPriorityClass(*class)
+	} else {
+		created, err = h.K8sClient.CreateWorkloadPriorityClass(*class)
+	}
+	if err != nil {
+		log.Printf("failed creating workload priority class: %v", err)
+		return sendClientError(c, "Workload priority class not found", err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(created)
+}
+
+// UpdateWorkloadPriorityClass replaces the value and description of a workload priority class
+// @Description Replace the value and description of a workload priority class. Workloads that already exist keep their priority.
+// @Description When resourceVersion is given and the workload priority class has been modified since, 409 is returned.
+// @Summary Update workload priority class
+// @Tags Workload Priority Classes
+// @Accept json
+// @Produce json
+// @Param name path string true "Workload priority class name"
+// @Param class body models.WorkloadPriorityClass true "Workload priority class"
+// @Success 200 {object} models.WorkloadPriorityClass
+// @Failure 400 {object} models.Error
+// @Failure 404 {object} models.Error
+// @Failure 409 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/workload-priority-classes/{name} [put]
+func (h Handlers) UpdateWorkloadPriorityClass(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var rawBody map[string]any
+	if err := c.BodyParser(&rawBody); err != nil {
+		return sendBadRequest(c, "Cannot parse JSON")
+	}
+
+	if _, exists := rawBody["name"]; !exists {
+		rawBody["name"] = name
+	}
+
+	resourceVersion, err := optionalString(rawBody, "resourceVersion")
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+	delete(rawBody, "resourceVersion")
+
+	class, err := validateWorkloadPriorityClassSchema(rawBody)
+	if err != nil {
+		return sendBadRequest(c, err.Error())
+	}
+	if class.Name != name {
+		return sendBadRequest(c, "field 'name' cannot be changed")
+	}
+	class.ResourceVersion = resourceVersion
+
+	var updated *models.WorkloadPriorityClass
+	if h.EnvClient.IsMockMode() {
+		updated, err = h.MockClient.UpdateWorkloadPriorityClass(*class)
+	} else {
+		updated, err = h.K8sClient.UpdateWorkloadPriorityClass(*class)
+	}
+	if err != nil {
+		log.Printf("failed updating workload priority class: %v", err)
+		return sendClientError(c, "Workload priority class not found", err)
+	}
+
+	return c.JSON(updated)
+}
+
+// DeleteWorkloadPriorityClass deletes a workload priority class
+// @Description Delete a workload priority class
+// @Summary Delete workload priority class
+// @Tags Workload Priority Classes
+// @Produce json
+// @Param name path string true "Workload priority class name"
+// @Success 204
+// @Failure 404 {object} models.Error
+// @Failure 502 {object} models.Error
+// @Router /api/v1/workload-priority-classes/{name} [delete]
+func (h Handlers) DeleteWorkloadPriorityClass(c *fiber.Ctx) error {
+	name := c.Params("name")
+
+	var err error
+	if h.EnvClient.IsMockMode() {
+		err = h.MockClient.DeleteWorkloadPriorityClass(name)
+	} else {
+		err = h.K8sClient.DeleteWorkloadPriorityClass(name)
+	}
+	if err != nil {
+		log.Printf("failed deleting workload priority class: %v", err)
+		return sendClientError(c, "Workload priority class not found", err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// validateWorkloadPriorityClassSchema validates the workload priority class creation and update requests
+func validateWorkloadPriorityClassSchema(rawBody map[string]any) (*models.WorkloadPriorityClass, error) {
+	err := checkAllowedFields(rawBody, "description", "name", "value")
+	if err != nil {
+		return nil, err
+	}
+
+	class := &models.WorkloadPriorityClass{}
+
+	if class.Name, err = requiredString(rawBody, "name"); err != nil {
+		return nil, err
+	}
+
+	value, err := optionalInt32(rawBody, "value")
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, fmt.Errorf("field 'value' is required")
+	}
+	class.Value = *value
+
+	if class.Description, err = optionalString(rawBody, "description"); err != nil {
+		return nil, err
+	}
+	if len(class.Description) > 2048 {
+		return nil, fmt.Errorf("field 'description' must be at most 2048 characters")
+	}
+
+	return class, nil
+}
@@ -0,0 +1,23 @@
+package models
+
+// AdmissionCheck represents a Kueue AdmissionCheck
+type AdmissionCheck struct {
+	Active         bool                      `json:"active"`
+	Conditions     []Condition               `json:"conditions,omitempty"`
+	ControllerName string                    `json:"controllerName"`
+	Name           string                    `json:"name"`
+	Parameters     *AdmissionCheckParameters `json:"parameters,omitempty"`
+}
+
+// AdmissionCheckParameters represents the reference to the object holding the parameters of an AdmissionCheck
+type AdmissionCheckParameters struct {
+	APIGroup string `json:"apiGroup"`
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+}
+
+// ClusterQueueAdmissionCheck represents an AdmissionCheck attached to a ClusterQueue, optionally only for some of its flavors
+type ClusterQueueAdmissionCheck struct {
+	Name      string   `json:"name"`
+	OnFlavors []string `json:"onFlavors,omitempty"`
+}
@@ -0,0 +1,24 @@
+package models
+
+// BindRequest represents a KAI Scheduler BindRequest, the request of the scheduler to bind a pod to the node it selected.
+// The binder retries a failed bind until the backoff limit is reached.
+type BindRequest struct {
+	BackoffLimit         *int32          `json:"backoffLimit,omitempty"`
+	CreationTimestamp    string          `json:"creationTimestamp"`
+	FailedAttempts       int32           `json:"failedAttempts"`
+	Name                 string          `json:"name"`
+	Namespace            string          `json:"namespace"`
+	Node                 string          `json:"node"`
+	Phase                string          `json:"phase,omitempty"`
+	Pod                  string          `json:"pod"`
+	Reason               string          `json:"reason,omitempty"`
+	ReceivedGPU          *BindRequestGPU `json:"receivedGPU,omitempty"`
+	ReceivedResourceType string          `json:"receivedResourceType,omitempty"`
+	SelectedGPUGroups    []string        `json:"selectedGPUGroups,omitempty"`
+}
+
+// BindRequestGPU represents the GPUs a pod receives, a portion below 1 is a share of each device
+type BindRequestGPU struct {
+	Count   int    `json:"count"`
+	Portion string `json:"portion,omitempty"`
+}
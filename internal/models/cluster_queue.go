@@ -0,0 +1,66 @@
+package models
+
+// BorrowWithinCohort represents the preemption policy when borrowing within a cohort
+type BorrowWithinCohort struct {
+	MaxPriorityThreshold *int32 `json:"maxPriorityThreshold,omitempty"`
+	Policy               string `json:"policy,omitempty"`
+}
+
+// ClusterQueue represents a Kueue ClusterQueue
+type ClusterQueue struct {
+	AdmissionChecks    []ClusterQueueAdmissionCheck `json:"admissionChecks,omitempty"`
+	AdmittedWorkloads  int32                        `json:"admittedWorkloads"`
+	Cohort             string                       `json:"cohort,omitempty"`
+	Conditions         []Condition                  `json:"conditions,omitempty"`
+	FlavorsReservation []FlavorUsage                `json:"flavorsReservation,omitempty"`
+	FlavorsUsage       []FlavorUsage                `json:"flavorsUsage,omitempty"`
+	Name               string                       `json:"name"`
+	NamespaceSelector  *LabelSelector               `json:"namespaceSelector,omitempty"`
+	PendingWorkloads   int32                        `json:"pendingWorkloads"`
+	Preemption         *ClusterQueuePreemption      `json:"preemption,omitempty"`
+	QueueingStrategy   string                       `json:"queueingStrategy,omitempty"`
+	ReservingWorkloads int32                        `json:"reservingWorkloads"`
+	ResourceGroups     []ResourceGroup              `json:"resourceGroups,omitempty"`
+	ResourceVersion    string                       `json:"resourceVersion,omitempty"`
+	StopPolicy         string                       `json:"stopPolicy,omitempty"`
+}
+
+// ClusterQueuePreemption represents the preemption policies of a ClusterQueue
+type ClusterQueuePreemption struct {
+	BorrowWithinCohort  *BorrowWithinCohort `json:"borrowWithinCohort,omitempty"`
+	ReclaimWithinCohort string              `json:"reclaimWithinCohort,omitempty"`
+	WithinClusterQueue  string              `json:"withinClusterQueue,omitempty"`
+}
+
+// FlavorQuotas represents the quotas of a flavor in a resource group
+type FlavorQuotas struct {
+	Name      string          `json:"name"`
+	Resources []ResourceQuota `json:"resources"`
+}
+
+// LabelSelector represents a Kubernetes label selector
+type LabelSelector struct {
+	MatchExpressions []LabelSelectorRequirement `json:"matchExpressions,omitempty"`
+	MatchLabels      map[string]string          `json:"matchLabels,omitempty"`
+}
+
+// LabelSelectorRequirement represents a single label selector expression
+type LabelSelectorRequirement struct {
+	Key      string   `json:"key"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values,omitempty"`
+}
+
+// ResourceGroup represents a set of resources that share the same flavors
+type ResourceGroup struct {
+	CoveredResources []string       `json:"coveredResources"`
+	Flavors          []FlavorQuotas `json:"flavors"`
+}
+
+// ResourceQuota represents the quota of a resource in a flavor
+type ResourceQuota struct {
+	BorrowingLimit string `json:"borrowingLimit,omitempty"`
+	LendingLimit   string `json:"lendingLimit,omitempty"`
+	Name           string `json:"name"`
+	NominalQuota   string `json:"nominalQuota"`
+}
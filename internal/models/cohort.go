@@ -0,0 +1,49 @@
+package models
+
+// ClusterQueueTreeNode represents a ClusterQueue and its LocalQueues in the cohort tree
+type ClusterQueueTreeNode struct {
+	LocalQueues []LocalQueueTreeNode `json:"localQueues,omitempty"`
+	Name        string               `json:"name"`
+	Resources   []ResourceTotal      `json:"resources,omitempty"`
+}
+
+// Cohort represents a Kueue Cohort
+type Cohort struct {
+	Name            string          `json:"name"`
+	Parent          string          `json:"parent,omitempty"`
+	ResourceGroups  []ResourceGroup `json:"resourceGroups,omitempty"`
+	ResourceVersion string          `json:"resourceVersion,omitempty"`
+}
+
+// CohortTree represents the Cohort, ClusterQueue and LocalQueue hierarchy
+type CohortTree struct {
+	ClusterQueues []ClusterQueueTreeNode `json:"clusterQueues,omitempty"`
+	Cohorts       []CohortTreeNode       `json:"cohorts,omitempty"`
+}
+
+// CohortTreeNode represents a Cohort with its child cohorts and ClusterQueues in the cohort tree.
+// Implicit cohorts are only referenced by name and have no Cohort object.
+type CohortTreeNode struct {
+	ChildCohorts  []CohortTreeNode       `json:"childCohorts,omitempty"`
+	ClusterQueues []ClusterQueueTreeNode `json:"clusterQueues,omitempty"`
+	Implicit      bool                   `json:"implicit"`
+	Name          string                 `json:"name"`
+	Parent        string                 `json:"parent,omitempty"`
+	Resources     []ResourceTotal        `json:"resources,omitempty"`
+}
+
+// LocalQueueTreeNode represents a LocalQueue in the cohort tree
+type LocalQueueTreeNode struct {
+	Name      string          `json:"name"`
+	Namespace string          `json:"namespace"`
+	Resources []ResourceTotal `json:"resources,omitempty"`
+}
+
+// ResourceTotal represents the quota and usage of a resource in a flavor, summed over a subtree of the cohort tree
+type ResourceTotal struct {
+	Borrowed     string `json:"borrowed,omitempty"`
+	Flavor       string `json:"flavor"`
+	NominalQuota string `json:"nominalQuota,omitempty"`
+	Resource     string `json:"resource"`
+	Usage        string `json:"usage"`
+}
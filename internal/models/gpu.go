@@ -0,0 +1,37 @@
+package models
+
+// GpuInventory represents the GPUs of the cluster per node and grouped by GPU model, with the totals by resource name
+type GpuInventory struct {
+	Models    []GpuModel             `json:"models"`
+	Nodes     []GpuNode              `json:"nodes"`
+	Resources map[string]GpuResource `json:"resources"`
+}
+
+// GpuModel represents the GPUs of one product across the nodes that carry it
+type GpuModel struct {
+	Memory    string                 `json:"memory,omitempty"`
+	Nodes     []string               `json:"nodes"`
+	Product   string                 `json:"product"`
+	Resources map[string]GpuResource `json:"resources"`
+}
+
+// GpuNode represents the GPUs of a node, as labelled by GPU feature discovery. Resources include MIG devices exposed with the mixed strategy.
+type GpuNode struct {
+	Count       int                    `json:"count,omitempty"`
+	Memory      string                 `json:"memory,omitempty"`
+	MigCapable  bool                   `json:"migCapable,omitempty"`
+	MigStrategy string                 `json:"migStrategy,omitempty"`
+	Name        string                 `json:"name"`
+	Product     string                 `json:"product"`
+	Ready       bool                   `json:"ready"`
+	Resources   map[string]GpuResource `json:"resources"`
+}
+
+// GpuResource represents the capacity and allocatable devices of a GPU resource, the devices requested by non-terminated pods
+// and the allocatable devices left over
+type GpuResource struct {
+	Allocatable int64 `json:"allocatable"`
+	Allocated   int64 `json:"allocated"`
+	Available   int64 `json:"available"`
+	Capacity    int64 `json:"capacity"`
+}
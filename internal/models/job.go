@@ -1,6 +1,55 @@
 package models
 
-// Job model
+// Job schedulers
+const (
+	JobSchedulerKAI   = "kai"
+	JobSchedulerKueue = "kueue"
+)
+
+// Job represents a batch/v1 Job submitted to a Kueue LocalQueue or, when Scheduler is "kai", to a KAI Scheduler queue
 type Job struct {
-	Name string `json:"name"`
+	Args          []string             `json:"args,omitempty"`
+	BackoffLimit  *int32               `json:"backoffLimit,omitempty"`
+	Command       []string             `json:"command,omitempty"`
+	Completions   *int32               `json:"completions,omitempty"`
+	Env           []EnvVar             `json:"env,omitempty"`
+	Image         string               `json:"image"`
+	Name          string               `json:"name"`
+	Namespace     string               `json:"namespace"`
+	Parallelism   *int32               `json:"parallelism,omitempty"`
+	PriorityClass string               `json:"priorityClass,omitempty"`
+	Queue         string               `json:"queue"`
+	Resources     ResourceRequirements `json:"resources,omitempty"`
+	Scheduler     string               `json:"scheduler,omitempty"`
+}
+
+// JobAdmission represents the Kueue admission state of the Workload created for a Job
+type JobAdmission struct {
+	Active       bool        `json:"active"`
+	ClusterQueue string      `json:"clusterQueue,omitempty"`
+	Conditions   []Condition `json:"conditions,omitempty"`
+	State        string      `json:"state"`
+	Workload     string      `json:"workload"`
+}
+
+// JobDetail represents a Job together with its live status
+type JobDetail struct {
+	Admission         *JobAdmission `json:"admission,omitempty"`
+	Conditions        []Condition   `json:"conditions,omitempty"`
+	CreationTimestamp string        `json:"creationTimestamp"`
+	Pods              []Pod         `json:"pods,omitempty"`
+	Spec              Job           `json:"spec"`
+	Status            JobStatus     `json:"status"`
+	UID               string        `json:"uid"`
+}
+
+// JobStatus represents the observed state of a Job
+type JobStatus struct {
+	Active         int32  `json:"active"`
+	CompletionTime string `json:"completionTime,omitempty"`
+	Failed         int32  `json:"failed"`
+	StartTime      string `json:"startTime,omitempty"`
+	State          string `json:"state"`
+	Succeeded      int32  `json:"succeeded"`
+	Suspended      bool   `json:"suspended"`
 }
@@ -19,6 +19,7 @@ type KaiSchedulerChildQueue struct {
 	Name      string                     `json:"name"`
 	Parent    string                     `json:"parent"`
 	Resources KaiSchedulerQueueResources `json:"resources"`
+	Status    *KaiSchedulerQueueStatus   `json:"status,omitempty"`
 }
 
 // KaiSchedulerParentQueue represents a parent queue in the kai scheduler hierarchy
@@ -26,4 +27,98 @@ type KaiSchedulerParentQueue struct {
 	ChildQueues []string                   `json:"child_queues,omitempty"`
 	Name        string                     `json:"name"`
 	Resources   KaiSchedulerQueueResources `json:"resources"`
+	Status      *KaiSchedulerQueueStatus   `json:"status,omitempty"`
+}
+
+// KaiSchedulerQueue represents a kai scheduler queue at any level of the hierarchy.
+// A quota or limit of -1 is unlimited, a queue without a parent is a top level queue.
+type KaiSchedulerQueue struct {
+	ChildQueues     []string                   `json:"child_queues,omitempty"`
+	DisplayName     string                     `json:"display_name,omitempty"`
+	Name            string                     `json:"name"`
+	Parent          string                     `json:"parent,omitempty"`
+	Priority        *int                       `json:"priority,omitempty"`
+	ResourceVersion string                     `json:"resource_version,omitempty"`
+	Resources       KaiSchedulerQueueResources `json:"resources"`
+	Status          *KaiSchedulerQueueStatus   `json:"status,omitempty"`
+}
+
+// KaiSchedulerQueueTree represents the full kai scheduler queue hierarchy.
+// Orphans are queues whose parent does not exist, cycles are groups of queues that are each other's ancestors.
+type KaiSchedulerQueueTree struct {
+	Cycles  [][]string                  `json:"cycles,omitempty"`
+	Orphans []string                    `json:"orphans,omitempty"`
+	Queues  []KaiSchedulerQueueTreeNode `json:"queues"`
+}
+
+// KaiSchedulerQueueTreeNode represents a queue with its child queues in the kai scheduler queue tree.
+// Orphaned queues and the first queue of each cycle are returned at the top level with the problem flagged.
+type KaiSchedulerQueueTreeNode struct {
+	ChildQueues []KaiSchedulerQueueTreeNode `json:"child_queues,omitempty"`
+	Cycle       bool                        `json:"cycle,omitempty"`
+	DisplayName string                      `json:"display_name,omitempty"`
+	Name        string                      `json:"name"`
+	Orphan      bool                        `json:"orphan,omitempty"`
+	Parent      string                      `json:"parent,omitempty"`
+	Priority    *int                        `json:"priority,omitempty"`
+	Resources   KaiSchedulerQueueResources  `json:"resources"`
+	Status      *KaiSchedulerQueueStatus    `json:"status,omitempty"`
+}
+
+// KaiSchedulerQueueStatus represents the resources a queue holds and asks for as reported by the kai scheduler,
+// with its utilisation of each resource against its quota and limit
+type KaiSchedulerQueueStatus struct {
+	Allocated               map[string]string             `json:"allocated,omitempty"`
+	AllocatedNonPreemptible map[string]string             `json:"allocated_non_preemptible,omitempty"`
+	Requested               map[string]string             `json:"requested,omitempty"`
+	Utilisation             KaiSchedulerQueueUtilisations `json:"utilisation"`
+}
+
+// KaiSchedulerQueueUtilisations represents the utilisation of each queue resource
+type KaiSchedulerQueueUtilisations struct {
+	Cpu    KaiSchedulerQueueUtilisation `json:"cpu"`
+	Gpu    KaiSchedulerQueueUtilisation `json:"gpu"`
+	Memory KaiSchedulerQueueUtilisation `json:"memory"`
+}
+
+// KaiSchedulerQueueUtilisation represents the use of one resource by a queue in the units of its quota: millicores, megabytes or devices.
+// Percentages are left out when the quota or limit is unlimited or zero. A queue is over quota when it holds more than its quota,
+// and starving when it asks for more than it holds while still under its quota.
+type KaiSchedulerQueueUtilisation struct {
+	Allocated               float64  `json:"allocated"`
+	AllocatedNonPreemptible float64  `json:"allocated_non_preemptible"`
+	AllocatedOfLimit        *float64 `json:"allocated_of_limit,omitempty"`
+	AllocatedOfQuota        *float64 `json:"allocated_of_quota,omitempty"`
+	OverQuota               bool     `json:"over_quota"`
+	Requested               float64  `json:"requested"`
+	RequestedOfQuota        *float64 `json:"requested_of_quota,omitempty"`
+	Starving                bool     `json:"starving"`
+}
+
+// KaiSchedulerQueueAmounts represents an amount of each queue resource in the units of the quota: millicores, megabytes and devices
+type KaiSchedulerQueueAmounts struct {
+	Cpu    float64 `json:"cpu"`
+	Gpu    float64 `json:"gpu"`
+	Memory float64 `json:"memory"`
+}
+
+// KaiSchedulerQueueSimulation represents the fair share of the cluster that every queue deserves for a given demand.
+// Capacity that no queue can use, because every queue is satisfied or at its limit, is left unallocated.
+type KaiSchedulerQueueSimulation struct {
+	Capacity    KaiSchedulerQueueAmounts          `json:"capacity"`
+	Queues      []KaiSchedulerQueueSimulatedShare `json:"queues"`
+	Unallocated KaiSchedulerQueueAmounts          `json:"unallocated"`
+}
+
+// KaiSchedulerQueueSimulatedShare represents the simulated share of a queue. The demand of a parent queue is the demand of its children.
+// The fair share is the deserved quota share plus the over quota share, a queue is starved of what it demands beyond its fair share.
+type KaiSchedulerQueueSimulatedShare struct {
+	Demand         KaiSchedulerQueueAmounts `json:"demand"`
+	FairShare      KaiSchedulerQueueAmounts `json:"fair_share"`
+	Name           string                   `json:"name"`
+	OverQuotaShare KaiSchedulerQueueAmounts `json:"over_quota_share"`
+	Parent         string                   `json:"parent,omitempty"`
+	Priority       int                      `json:"priority"`
+	QuotaShare     KaiSchedulerQueueAmounts `json:"quota_share"`
+	Starved        KaiSchedulerQueueAmounts `json:"starved"`
 }
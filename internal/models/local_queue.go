@@ -27,11 +27,13 @@ type LocalQueue struct {
 	Namespace          string        `json:"namespace"`
 	PendingWorkloads   int32         `json:"pendingWorkloads"`
 	ReservingWorkloads int32         `json:"reservingWorkloads"`
+	ResourceVersion    string        `json:"resourceVersion,omitempty"`
 	StopPolicy         string        `json:"stopPolicy,omitempty"`
 }
 
 // ResourceUsage represents the usage of a specific resource
 type ResourceUsage struct {
-	Name  string `json:"name"`
-	Total string `json:"total,omitempty"`
+	Borrowed string `json:"borrowed,omitempty"`
+	Name     string `json:"name"`
+	Total    string `json:"total,omitempty"`
 }
@@ -0,0 +1,61 @@
+package models
+
+// MetricsUsage represents the current CPU and memory usage reported by the metrics API, as a percentage of the allocatable
+// resources of a node or of the requests of a pod. Usage is averaged over the window ending at the timestamp.
+type MetricsUsage struct {
+	CPU           string   `json:"cpu"`
+	CPUPercent    *float64 `json:"cpuPercent,omitempty"`
+	Memory        string   `json:"memory"`
+	MemoryPercent *float64 `json:"memoryPercent,omitempty"`
+	Timestamp     string   `json:"timestamp,omitempty"`
+	Window        string   `json:"window,omitempty"`
+}
+
+// NodeUsage represents the current usage of a node
+type NodeUsage struct {
+	Name  string       `json:"name"`
+	Usage MetricsUsage `json:"usage"`
+}
+
+// PodUsage represents the current usage of a pod, summed over its containers
+type PodUsage struct {
+	Name      string       `json:"name"`
+	Namespace string       `json:"namespace"`
+	Node      string       `json:"node,omitempty"`
+	Usage     MetricsUsage `json:"usage"`
+}
+
+// TopUsage represents the nodes and pods using the most CPU or memory
+type TopUsage struct {
+	Nodes  []NodeUsage `json:"nodes"`
+	Pods   []PodUsage  `json:"pods"`
+	SortBy string      `json:"sortBy"`
+}
+
+// NodeMetricsList represents a list of node metrics from the metrics API, the fields of metrics.k8s.io/v1beta1 NodeMetricsList that are read
+type NodeMetricsList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Timestamp string            `json:"timestamp"`
+		Usage     map[string]string `json:"usage"`
+		Window    string            `json:"window"`
+	} `json:"items"`
+}
+
+// PodMetricsList represents a list of pod metrics from the metrics API, the fields of metrics.k8s.io/v1beta1 PodMetricsList that are read
+type PodMetricsList struct {
+	Items []struct {
+		Containers []struct {
+			Name  string            `json:"name"`
+			Usage map[string]string `json:"usage"`
+		} `json:"containers"`
+		Metadata struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"metadata"`
+		Timestamp string `json:"timestamp"`
+		Window    string `json:"window"`
+	} `json:"items"`
+}
@@ -0,0 +1,17 @@
+package models
+
+// MultiKueueCluster represents a Kueue MultiKueueCluster, a worker cluster the manager cluster dispatches workloads to
+type MultiKueueCluster struct {
+	Active         bool                  `json:"active"`
+	ClusterProfile string                `json:"clusterProfile,omitempty"`
+	Conditions     []Condition           `json:"conditions,omitempty"`
+	KubeConfig     *MultiKueueKubeConfig `json:"kubeConfig,omitempty"`
+	Name           string                `json:"name"`
+}
+
+// MultiKueueKubeConfig represents where the kubeconfig of a worker cluster is stored.
+// A Secret location is the name of a Secret holding a "kubeconfig" key in the namespace of the Kueue controller manager.
+type MultiKueueKubeConfig struct {
+	Location     string `json:"location"`
+	LocationType string `json:"locationType"`
+}
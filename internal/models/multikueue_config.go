@@ -0,0 +1,8 @@
+package models
+
+// MultiKueueConfig represents a Kueue MultiKueueConfig, the worker clusters a MultiKueue admission check dispatches to
+type MultiKueueConfig struct {
+	Clusters        []string `json:"clusters"`
+	Name            string   `json:"name"`
+	ResourceVersion string   `json:"resourceVersion,omitempty"`
+}
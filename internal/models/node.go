@@ -2,13 +2,32 @@ package models
 
 // Node represents a Kubernetes node
 type Node struct {
-	CPU            string `json:"cpu"`
-	IP             string `json:"ip"`
-	KubeletVersion string `json:"kubeletVersion"`
-	Memory         string `json:"memory"`
-	Name           string `json:"name"`
-	Ready          bool   `json:"ready"`
-	Roles          string `json:"roles"`
+	Allocatable    NodeResources     `json:"allocatable,omitempty"`
+	Allocated      NodeAllocated     `json:"allocated,omitempty"`
+	CPU            string            `json:"cpu"`
+	Capacity       NodeResources     `json:"capacity,omitempty"`
+	IP             string            `json:"ip"`
+	KubeletVersion string            `json:"kubeletVersion"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	Memory         string            `json:"memory"`
+	Name           string            `json:"name"`
+	Ready          bool              `json:"ready"`
+	Roles          string            `json:"roles"`
+	Taints         []NodeTaint       `json:"taints,omitempty"`
+	Usage          *MetricsUsage     `json:"usage,omitempty"`
+}
+
+// NodeAllocated represents the resources allocated on a node by resource name
+type NodeAllocated map[string]NodeAllocatedResource
+
+// NodeAllocatedResource represents the requests and limits of a resource summed over the non-terminated pods on a node,
+// with their percentage of the allocatable amount. Limits may add up to more than the allocatable amount.
+type NodeAllocatedResource struct {
+	Allocatable     string   `json:"allocatable"`
+	Limits          string   `json:"limits"`
+	LimitsPercent   *float64 `json:"limitsPercent,omitempty"`
+	Requests        string   `json:"requests"`
+	RequestsPercent *float64 `json:"requestsPercent,omitempty"`
 }
 
 // NodeAddress represents a node address
@@ -31,19 +50,23 @@ type NodeCondition struct {
 type NodeDetail struct {
 	Addresses         []NodeAddress     `json:"addresses,omitempty"`
 	Allocatable       NodeResources     `json:"allocatable,omitempty"`
+	Allocated         NodeAllocated     `json:"allocated,omitempty"`
 	Annotations       map[string]string `json:"annotations,omitempty"`
 	Capacity          NodeResources     `json:"capacity,omitempty"`
 	Conditions        []NodeCondition   `json:"conditions,omitempty"`
 	CreationTimestamp string            `json:"creationTimestamp"`
+	Flavors           []string          `json:"flavors,omitempty"`
 	Images            []NodeImage       `json:"images,omitempty"`
 	Labels            map[string]string `json:"labels,omitempty"`
 	Name              string            `json:"name"`
 	NodeInfo          NodeSystemInfo    `json:"nodeInfo"`
 	PodCIDR           string            `json:"podCIDR,omitempty"`
+	Pods              []Pod             `json:"pods,omitempty"`
 	Ready             bool              `json:"ready"`
 	Roles             string            `json:"roles"`
 	Taints            []NodeTaint       `json:"taints,omitempty"`
 	UID               string            `json:"uid"`
+	Unschedulable     bool              `json:"unschedulable"`
 }
 
 // NodeImage represents a container image on a node
@@ -99,6 +122,7 @@ type NodeItem struct {
 			TimeAdded string `json:"timeAdded,omitempty"`
 			Value     string `json:"value,omitempty"`
 		} `json:"taints,omitempty"`
+		Unschedulable bool `json:"unschedulable,omitempty"`
 	} `json:"spec"`
 	Status struct {
 		Addresses []struct {
@@ -133,3 +157,25 @@ type NodeItem struct {
 		} `json:"nodeInfo"`
 	} `json:"status"`
 }
+
+// NodePod represents a pod running on a node, owner is the kind and name of its controller
+type NodePod struct {
+	DaemonSet bool   `json:"daemonSet,omitempty"`
+	EmptyDir  bool   `json:"emptyDir,omitempty"`
+	Mirror    bool   `json:"mirror,omitempty"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Owner     string `json:"owner,omitempty"`
+	Phase     string `json:"phase"`
+}
+
+// NodeLabelsAndTaints represents the labels and taints of a node after a change, with the resource flavors that cover it.
+// Flavors is null with the reason in FlavorsError when the resource flavors could not be read.
+type NodeLabelsAndTaints struct {
+	Error        string            `json:"error,omitempty"`
+	Flavors      []string          `json:"flavors"`
+	FlavorsError string            `json:"flavorsError,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Name         string            `json:"name"`
+	Taints       []NodeTaint       `json:"taints,omitempty"`
+}
@@ -0,0 +1,43 @@
+package models
+
+// Node drain statuses
+const (
+	NodeDrainCompleted = "Completed"
+	NodeDrainFailed    = "Failed"
+	NodeDrainRunning   = "Running"
+	NodeDrainTimedOut  = "TimedOut"
+)
+
+// NodeDrainOptions represents the options of a node drain. Without a grace period pods get their own termination grace period,
+// force also evicts pods that are not managed by a controller and will not be recreated, deleteEmptyDirData also evicts pods
+// with emptyDir volumes, whose data is deleted with them.
+type NodeDrainOptions struct {
+	DeleteEmptyDirData bool   `json:"deleteEmptyDirData"`
+	Force              bool   `json:"force"`
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+	TimeoutSeconds     int64  `json:"timeoutSeconds"`
+}
+
+// NodeDrain represents the progress of a node drain. Evicted pods have left the node, remaining pods are still on it and
+// blocked pods are remaining pods whose eviction was refused, by a PodDisruptionBudget, because they have no controller or
+// because they have emptyDir volumes.
+// DaemonSet pods, static pods and finished pods are skipped.
+type NodeDrain struct {
+	Blocked     []NodeDrainPod   `json:"blocked,omitempty"`
+	CompletedAt string           `json:"completedAt,omitempty"`
+	Error       string           `json:"error,omitempty"`
+	Evicted     []NodeDrainPod   `json:"evicted,omitempty"`
+	Node        string           `json:"node"`
+	Options     NodeDrainOptions `json:"options"`
+	Remaining   []NodeDrainPod   `json:"remaining,omitempty"`
+	Skipped     []NodeDrainPod   `json:"skipped,omitempty"`
+	StartedAt   string           `json:"startedAt"`
+	Status      string           `json:"status"`
+}
+
+// NodeDrainPod represents a pod of a node drain with the reason it was skipped or blocked
+type NodeDrainPod struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Reason    string `json:"reason,omitempty"`
+}
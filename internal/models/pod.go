@@ -25,13 +25,16 @@ type OwnerReference struct {
 
 // Pod represents a Kubernetes pod
 type Pod struct {
-	Name        string `json:"name"`
-	Namespace   string `json:"namespace"`
-	Node        string `json:"node"`
-	PodIP       string `json:"podIP"`
-	Restarts    int    `json:"restarts"`
-	Status      string `json:"status"`
-	StatusClass string `json:"statusClass"`
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace"`
+	Node        string            `json:"node"`
+	PodIP       string            `json:"podIP"`
+	Limits      map[string]string `json:"limits,omitempty"`
+	Requests    map[string]string `json:"requests,omitempty"`
+	Restarts    int               `json:"restarts"`
+	Status      string            `json:"status"`
+	StatusClass string            `json:"statusClass"`
+	Usage       *MetricsUsage     `json:"usage,omitempty"`
 }
 
 // PodCondition represents a pod condition
@@ -61,6 +64,7 @@ type PodContainer struct {
 // PodDetail represents detailed Kubernetes pod information
 type PodDetail struct {
 	Annotations       map[string]string `json:"annotations,omitempty"`
+	BindRequests      []BindRequest     `json:"bindRequests,omitempty"`
 	Conditions        []PodCondition    `json:"conditions,omitempty"`
 	Containers        []PodContainer    `json:"containers,omitempty"`
 	CreationTimestamp string            `json:"creationTimestamp"`
@@ -146,11 +150,16 @@ type PodItem struct {
 			} `json:"volumeMounts,omitempty"`
 		} `json:"containers"`
 		InitContainers []struct {
-			Image string `json:"image"`
-			Name  string `json:"name"`
+			Image     string `json:"image"`
+			Name      string `json:"name"`
+			Resources struct {
+				Limits   map[string]string `json:"limits,omitempty"`
+				Requests map[string]string `json:"requests,omitempty"`
+			} `json:"resources,omitempty"`
 		} `json:"initContainers,omitempty"`
-		NodeName           string `json:"nodeName,omitempty"`
-		ServiceAccountName string `json:"serviceAccountName,omitempty"`
+		NodeName           string            `json:"nodeName,omitempty"`
+		Overhead           map[string]string `json:"overhead,omitempty"`
+		ServiceAccountName string            `json:"serviceAccountName,omitempty"`
 		Volumes            []struct {
 			ConfigMap *struct {
 				Name string `json:"name"`
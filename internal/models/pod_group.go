@@ -0,0 +1,44 @@
+package models
+
+// PodGroup represents a KAI Scheduler PodGroup, the pods of a job that are scheduled together in a queue.
+// The group is only scheduled once minMember of its pods can be bound at the same time.
+type PodGroup struct {
+	Bound                int32                         `json:"bound"`
+	Conditions           []Condition                   `json:"conditions,omitempty"`
+	CreationTimestamp    string                        `json:"creationTimestamp"`
+	Failed               int32                         `json:"failed"`
+	LastSchedulerMessage string                        `json:"lastSchedulerMessage,omitempty"`
+	MinMember            int32                         `json:"minMember"`
+	Name                 string                        `json:"name"`
+	Namespace            string                        `json:"namespace"`
+	Pending              int32                         `json:"pending"`
+	Phase                string                        `json:"phase,omitempty"`
+	Pods                 []PodGroupPod                 `json:"pods,omitempty"`
+	Queue                string                        `json:"queue"`
+	Running              int32                         `json:"running"`
+	SchedulingConditions []PodGroupSchedulingCondition `json:"schedulingConditions,omitempty"`
+	Succeeded            int32                         `json:"succeeded"`
+}
+
+// PodGroupPod represents a pod of a PodGroup, a pod with a node is bound
+type PodGroupPod struct {
+	Name  string `json:"name"`
+	Node  string `json:"node,omitempty"`
+	Phase string `json:"phase"`
+}
+
+// PodGroupSchedulingCondition represents the outcome of a scheduling attempt of a PodGroup on a node pool
+type PodGroupSchedulingCondition struct {
+	LastTransitionTime string                        `json:"lastTransitionTime"`
+	Message            string                        `json:"message,omitempty"`
+	NodePool           string                        `json:"nodePool,omitempty"`
+	Reasons            []PodGroupUnschedulableReason `json:"reasons,omitempty"`
+	Status             string                        `json:"status"`
+	Type               string                        `json:"type"`
+}
+
+// PodGroupUnschedulableReason represents a reason the scheduler gave for not scheduling a PodGroup
+type PodGroupUnschedulableReason struct {
+	Message string `json:"message,omitempty"`
+	Reason  string `json:"reason"`
+}
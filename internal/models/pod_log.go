@@ -0,0 +1,11 @@
+package models
+
+// PodLogOptions represents the options for reading the logs of a pod container
+type PodLogOptions struct {
+	Container    string `json:"container,omitempty"`
+	Follow       bool   `json:"follow"`
+	Previous     bool   `json:"previous"`
+	SinceSeconds *int64 `json:"sinceSeconds,omitempty"`
+	TailLines    *int64 `json:"tailLines,omitempty"`
+	Timestamps   bool   `json:"timestamps"`
+}
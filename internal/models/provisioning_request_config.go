@@ -0,0 +1,18 @@
+package models
+
+// ProvisioningRequestConfig represents a Kueue ProvisioningRequestConfig used by provisioning admission checks
+type ProvisioningRequestConfig struct {
+	ManagedResources      []string                          `json:"managedResources,omitempty"`
+	Name                  string                            `json:"name"`
+	Parameters            map[string]string                 `json:"parameters,omitempty"`
+	PodSetMergePolicy     string                            `json:"podSetMergePolicy,omitempty"`
+	ProvisioningClassName string                            `json:"provisioningClassName"`
+	RetryStrategy         *ProvisioningRequestRetryStrategy `json:"retryStrategy,omitempty"`
+}
+
+// ProvisioningRequestRetryStrategy represents the backoff applied when a ProvisioningRequest fails
+type ProvisioningRequestRetryStrategy struct {
+	BackoffBaseSeconds *int32 `json:"backoffBaseSeconds,omitempty"`
+	BackoffLimitCount  *int32 `json:"backoffLimitCount,omitempty"`
+	BackoffMaxSeconds  *int32 `json:"backoffMaxSeconds,omitempty"`
+}
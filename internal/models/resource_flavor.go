@@ -2,11 +2,30 @@ package models
 
 // ResourceFlavor represents a Kueue ResourceFlavor
 type ResourceFlavor struct {
-	Name         string            `json:"name"`
-	NodeLabels   map[string]string `json:"nodeLabels,omitempty"`
-	NodeTaints   []NodeTaint       `json:"nodeTaints,omitempty"`
-	Tolerations  []Toleration      `json:"tolerations,omitempty"`
-	TopologyName string            `json:"topologyName,omitempty"`
+	Name            string            `json:"name"`
+	NodeLabels      map[string]string `json:"nodeLabels,omitempty"`
+	NodeTaints      []NodeTaint       `json:"nodeTaints,omitempty"`
+	ResourceVersion string            `json:"resourceVersion,omitempty"`
+	Tolerations     []Toleration      `json:"tolerations,omitempty"`
+	TopologyName    string            `json:"topologyName,omitempty"`
+}
+
+// ResourceFlavorNodes represents the nodes a ResourceFlavor covers with their summed total and allocatable CPU, memory and GPUs.
+// Error is set when the flavor matches no node, workloads assigned to it could never be scheduled.
+type ResourceFlavorNodes struct {
+	Allocatable NodeResources        `json:"allocatable"`
+	Capacity    NodeResources        `json:"capacity"`
+	Error       string               `json:"error,omitempty"`
+	Flavor      string               `json:"flavor"`
+	Nodes       []ResourceFlavorNode `json:"nodes"`
+}
+
+// ResourceFlavorNode represents a node covered by a ResourceFlavor with its total and allocatable CPU, memory and GPUs
+type ResourceFlavorNode struct {
+	Allocatable NodeResources `json:"allocatable"`
+	Capacity    NodeResources `json:"capacity"`
+	Name        string        `json:"name"`
+	Ready       bool          `json:"ready"`
 }
 
 // Toleration represents a Kubernetes toleration
@@ -0,0 +1,28 @@
+package models
+
+// FlavorTopology represents the nodes matched by a ResourceFlavor grouped into the domains of its Topology
+type FlavorTopology struct {
+	Domains         []TopologyDomain `json:"domains,omitempty"`
+	Flavor          string           `json:"flavor"`
+	Levels          []string         `json:"levels"`
+	NotReadyNodes   []string         `json:"notReadyNodes,omitempty"`
+	Topology        string           `json:"topology"`
+	UnlabelledNodes []string         `json:"unlabelledNodes,omitempty"`
+}
+
+// Topology represents a Kueue Topology, its levels are node labels ordered from the widest domain to the narrowest
+type Topology struct {
+	Levels []string `json:"levels"`
+	Name   string   `json:"name"`
+}
+
+// TopologyDomain represents a domain at one topology level with the summed capacity of the ready nodes it contains
+type TopologyDomain struct {
+	Allocatable NodeResources    `json:"allocatable"`
+	Domains     []TopologyDomain `json:"domains,omitempty"`
+	Free        NodeResources    `json:"free"`
+	Level       string           `json:"level"`
+	Nodes       int              `json:"nodes"`
+	Requested   NodeResources    `json:"requested"`
+	Value       string           `json:"value"`
+}
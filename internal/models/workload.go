@@ -0,0 +1,46 @@
+package models
+
+// Workload represents a Kueue Workload
+type Workload struct {
+	Active            bool                     `json:"active"`
+	AdmissionChecks   []WorkloadAdmissionCheck `json:"admissionChecks,omitempty"`
+	ClusterName       string                   `json:"clusterName,omitempty"`
+	ClusterQueue      string                   `json:"clusterQueue,omitempty"`
+	Conditions        []Condition              `json:"conditions,omitempty"`
+	CreationTimestamp string                   `json:"creationTimestamp"`
+	Name              string                   `json:"name"`
+	Namespace         string                   `json:"namespace"`
+	NominatedClusters []string                 `json:"nominatedClusters,omitempty"`
+	Owners            []OwnerReference         `json:"owners,omitempty"`
+	PodSets           []WorkloadPodSet         `json:"podSets"`
+	Priority          *int32                   `json:"priority,omitempty"`
+	PriorityClass     string                   `json:"priorityClass,omitempty"`
+	Queue             string                   `json:"queue"`
+	RequeueState      *WorkloadRequeueState    `json:"requeueState,omitempty"`
+	State             string                   `json:"state"`
+}
+
+// WorkloadAdmissionCheck represents the state of an admission check for a Workload
+type WorkloadAdmissionCheck struct {
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+	Message            string `json:"message,omitempty"`
+	Name               string `json:"name"`
+	RetryCount         *int32 `json:"retryCount,omitempty"`
+	State              string `json:"state"`
+}
+
+// WorkloadPodSet represents a set of identical pods in a Workload with the flavors assigned to it on admission
+type WorkloadPodSet struct {
+	Count         int32             `json:"count"`
+	Flavors       map[string]string `json:"flavors,omitempty"`
+	MinCount      *int32            `json:"minCount,omitempty"`
+	Name          string            `json:"name"`
+	Requests      map[string]string `json:"requests,omitempty"`
+	ResourceUsage map[string]string `json:"resourceUsage,omitempty"`
+}
+
+// WorkloadRequeueState represents the backoff state of an evicted Workload
+type WorkloadRequeueState struct {
+	Count     *int32 `json:"count,omitempty"`
+	RequeueAt string `json:"requeueAt,omitempty"`
+}
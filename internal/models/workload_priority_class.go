@@ -0,0 +1,9 @@
+package models
+
+// WorkloadPriorityClass represents a Kueue WorkloadPriorityClass
+type WorkloadPriorityClass struct {
+	Description     string `json:"description,omitempty"`
+	Name            string `json:"name"`
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+	Value           int32  `json:"value"`
+}
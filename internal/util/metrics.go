@@ -0,0 +1,54 @@
+package util
+
+import (
+	"cmyk/internal/models"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// NodeUsages returns the usage of each node in a node metrics list
+func NodeUsages(list models.NodeMetricsList) []models.NodeUsage {
+	var result []models.NodeUsage
+	for _, m := range list.Items {
+		result = append(result, models.NodeUsage{
+			Name:  m.Metadata.Name,
+			Usage: metricsUsage([]map[string]string{m.Usage}, m.Timestamp, m.Window),
+		})
+	}
+	return result
+}
+
+// PodUsages returns the usage of each pod in a pod metrics list, summed over its containers
+func PodUsages(list models.PodMetricsList) []models.PodUsage {
+	var result []models.PodUsage
+	for _, m := range list.Items {
+		var usages []map[string]string
+		for _, container := range m.Containers {
+			usages = append(usages, container.Usage)
+		}
+		result = append(result, models.PodUsage{
+			Name:      m.Metadata.Name,
+			Namespace: m.Metadata.Namespace,
+			Usage:     metricsUsage(usages, m.Timestamp, m.Window),
+		})
+	}
+	return result
+}
+
+func metricsUsage(usages []map[string]string, timestamp, window string) models.MetricsUsage {
+	var cpu, memory resource.Quantity
+	for _, usage := range usages {
+		if q, err := resource.ParseQuantity(usage["cpu"]); err == nil {
+			cpu.Add(q)
+		}
+		if q, err := resource.ParseQuantity(usage["memory"]); err == nil {
+			memory.Add(q)
+		}
+	}
+	return models.MetricsUsage{
+		CPU:       cpu.String(),
+		Memory:    memory.String(),
+		Timestamp: timestamp,
+		Window:    window,
+	}
+}
@@ -0,0 +1,32 @@
+package util
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PodResources returns the requests or limits of a single pod from those of its containers, init containers and overhead.
+// Containers run side by side so they add up, init containers run one at a time before them so only the largest counts,
+// the way the scheduler and kubectl describe node count them.
+func PodResources(containers, initContainers []corev1.ResourceList, overhead corev1.ResourceList) corev1.ResourceList {
+	result := corev1.ResourceList{}
+	for _, list := range containers {
+		for name, q := range list {
+			total := result[name]
+			total.Add(q)
+			result[name] = total
+		}
+	}
+	for _, list := range initContainers {
+		for name, q := range list {
+			if current, exists := result[name]; !exists || q.Cmp(current) > 0 {
+				result[name] = q
+			}
+		}
+	}
+	for name, q := range overhead {
+		total := result[name]
+		total.Add(q)
+		result[name] = total
+	}
+	return result
+}